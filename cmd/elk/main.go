@@ -3,10 +3,12 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path"
 
 	"path/filepath"
+	"time"
 
 	"github.com/elk-language/elk"
 	_ "github.com/elk-language/elk"
@@ -14,6 +16,7 @@ import (
 	"github.com/elk-language/elk/ext"
 	"github.com/elk-language/elk/ext/std/test"
 	"github.com/elk-language/elk/lexer"
+	"github.com/elk-language/elk/position/diagnostic"
 	"github.com/elk-language/elk/repl"
 	"github.com/elk-language/elk/types/checker"
 	"github.com/elk-language/elk/vm"
@@ -37,10 +40,16 @@ func main() {
 		fs.Parse(os.Args[2:])
 		repl.Run(context.Background(), *disassemble, *transpile, *native, *inspectStack, *parse, *lex, *typecheck, *expand)
 	case "run":
-		if len(os.Args) < 3 {
-			runMain()
+		fs := pflag.NewFlagSet("run", pflag.ExitOnError)
+		watchReload := fs.Bool("watch-reload", false, "watch the source files and reload changed methods in the running program")
+		fs.Parse(os.Args[2:])
+
+		// arguments after `--` get forwarded to the program
+		args := fs.Args()
+		if len(args) == 0 || fs.ArgsLenAtDash() == 0 {
+			runMain(*watchReload, args)
 		} else {
-			runFile(os.Args[2])
+			runFile(args[0], *watchReload, args[1:])
 		}
 	case "compile":
 		if len(os.Args) < 3 {
@@ -62,7 +71,10 @@ func main() {
 }
 
 // Attempt to execute the given file.
-func runFile(fileName string) {
+// When `watchReload` is true the source files get watched
+// and changed methods are reloaded in the running program.
+// `args` are available in the program through `OS.args`.
+func runFile(fileName string, watchReload bool, args []string) {
 	absFileName, err := filepath.Abs(fileName)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Could not find file `%s`\n", fileName)
@@ -74,37 +86,72 @@ func runFile(fileName string) {
 		os.Exit(1)
 	}
 
-	bytecode, diagnostics := checker.CheckFile(fileName, nil, bitfield.BitField16{}, nil)
+	var bytecode *vm.BytecodeFunction
+	var diagnostics diagnostic.DiagnosticList
+	var reloader *checker.Reloader
+	if watchReload {
+		reloader = checker.NewReloader(fileName, bitfield.BitField16{})
+		bytecode, diagnostics = reloader.Check()
+	} else {
+		bytecode, diagnostics = checker.CheckFile(fileName, nil, bitfield.BitField16{}, nil)
+	}
 	if diagnostics != nil {
-		fmt.Println()
-
-		diagnosticString, err := diagnostics.HumanString(true, lexer.Colorizer{})
-		if err != nil {
-			panic(err)
-		}
-		fmt.Println(diagnosticString)
+		printDiagnostics(os.Stdout, diagnostics)
 		if diagnostics.IsFailure() {
 			os.Exit(1)
 		}
 	}
 
+	if reloader != nil {
+		done := make(chan struct{})
+		defer close(done)
+		go reloader.Watch(reloadInterval, done, func(diagnostics diagnostic.DiagnosticList, applied bool) {
+			// stdout belongs to the running program
+			if diagnostics != nil {
+				printDiagnostics(os.Stderr, diagnostics)
+			}
+			if applied {
+				fmt.Fprintln(os.Stderr, "reloaded methods")
+			} else {
+				fmt.Fprintln(os.Stderr, "could not reload methods")
+			}
+		})
+	}
+
+	vm.SetArgs(args)
 	v := vm.New()
 	_, elkErr := v.InterpretTopLevel(bytecode)
 	if !elkErr.IsUndefined() {
 		vm.PrintError(os.Stderr, v.ErrStackTrace(), elkErr)
+		v.RunAtExitHooks()
 		os.Exit(1)
 	}
+	v.RunAtExitHooks()
+}
+
+// How often source files are checked for changes
+// when running with `--watch-reload`
+const reloadInterval = 500 * time.Millisecond
+
+func printDiagnostics(w io.Writer, diagnostics diagnostic.DiagnosticList) {
+	fmt.Fprintln(w)
+
+	diagnosticString, err := diagnostics.HumanString(true, lexer.Colorizer{})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintln(w, diagnosticString)
 }
 
 // Attempt to execute the main file in the current working directory
-func runMain() {
+func runMain(watchReload bool, args []string) {
 	cwd, err := os.Getwd()
 	if err != nil {
 		panic(err)
 	}
 
 	mainPath := path.Join(cwd, "main.elk")
-	runFile(mainPath)
+	runFile(mainPath, watchReload, args)
 }
 
 // Attempt to compile the given file.
@@ -148,7 +195,7 @@ func runTest(main string, grep string, paths []string) {
 }
 
 func runTestFile(fileName string) {
-	runFile(fileName)
+	runFile(fileName, false, nil)
 	testExt := ext.Map["std/test"]
 	if !testExt.Initialised {
 		testExt.RuntimeInit()
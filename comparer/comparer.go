@@ -57,7 +57,8 @@ func init() {
 			value.Class{},
 			bitfield.BitField8{},
 		),
-		cmp.AllowUnexported(vm.BytecodeFunction{}, vm.GetterMethod{}, vm.SetterMethod{}),
+		cmp.AllowUnexported(vm.BytecodeFunction{}, vm.GetterMethod{}, vm.SetterMethod{}, vm.CallSiteInfo{}),
+		cmpopts.IgnoreFields(vm.BytecodeFunction{}, "replacement", "GlobalEnv"),
 		cmpopts.IgnoreFields(ast.ConstructorCallNode{}, "Method"),
 		cmpopts.IgnoreFields(ast.GenericConstructorCallNode{}, "Method"),
 		cmpopts.IgnoreFields(ast.NewExpressionNode{}, "Method"),
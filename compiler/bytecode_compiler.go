@@ -57,6 +57,15 @@ func (c *BytecodeCompiler) CreateMainCompiler(checker types.Checker, loc *positi
 	return compiler
 }
 
+// Define local variables that will be visible
+// in main compilers created by this compiler.
+// Used to predefine local variables in code evaluated at runtime.
+func (c *BytecodeCompiler) DefineLocals(names ...string) {
+	for _, name := range names {
+		c.defineLocal(name, nil)
+	}
+}
+
 func (c *BytecodeCompiler) InitGlobalEnv() Compiler {
 	envCompiler := NewBytecodeCompiler("<namespaceDefinitions>", topLevelBytecodeCompilerMode, c.bytecode.Location, c.checker, c.globalData)
 	envCompiler.additionalAbortChecks = c.additionalAbortChecks
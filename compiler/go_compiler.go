@@ -13806,7 +13806,10 @@ func (c *GoCompiler) compileLaxEqual(left *goValue, right *goValue, typ types.Ty
 	case "value.Float32":
 		return c.compileLaxEqualStrictFloat("value.Float32", narrowLeft, right, valueIsIgnored)
 	case "value.String":
-		return c.compileLaxEqualPrimitive("value.String", narrowLeft, right, valueIsIgnored)
+		// matching a regex may fail so it requires a method call
+		if !c.checker.TypesIntersect(right.elkType, c.checker.Std(symbol.Regex)) {
+			return c.compileLaxEqualPrimitive("value.String", narrowLeft, right, valueIsIgnored)
+		}
 	case "value.Char":
 		return c.compileLaxEqualPrimitive("value.Char", narrowLeft, right, valueIsIgnored)
 	case "*value.BigFloat":
@@ -13828,7 +13831,7 @@ func (c *GoCompiler) compileLaxEqual(left *goValue, right *goValue, typ types.Ty
 		}
 	}
 
-	if c.checker.IsSubtype(left.elkType, c.checker.Std(symbol.S_BuiltinEquatable)) {
+	if c.checker.IsSubtype(left.elkType, c.checker.Std(symbol.S_BuiltinEquatable)) && !c.laxEqualMayMatchRegex(left, right) {
 		if valueIsIgnored {
 			return nilGoValue
 		}
@@ -13855,6 +13858,16 @@ func (c *GoCompiler) compileLaxEqual(left *goValue, right *goValue, typ types.Ty
 	)
 }
 
+// Whether `=~` may match a regex against a string,
+// which can fail when the regex exceeds the step limit.
+func (c *GoCompiler) laxEqualMayMatchRegex(left, right *goValue) bool {
+	stringType := c.checker.StdString()
+	regexType := c.checker.Std(symbol.Regex)
+
+	return c.checker.TypesIntersect(left.elkType, stringType) && c.checker.TypesIntersect(right.elkType, regexType) ||
+		c.checker.TypesIntersect(left.elkType, regexType) && c.checker.TypesIntersect(right.elkType, stringType)
+}
+
 func (c *GoCompiler) compileStrictEqual(left *goValue, right *goValue, typ types.Type, loc *position.Location, valueIsIgnored bool) *goValue {
 	narrowLeft := c.convertValueToNarrowerType(left)
 
@@ -17174,6 +17174,72 @@ func main() { // loc: <main>
 	l1 = (value.SmallInt(5)).ToValue()
 	l2 = value.Bool((l0).LaxEqual(l1))
 }
+`,
+		},
+		"lax equal string regex": {
+			input: `
+				a := "hello"
+				b := %/(?<=h)e/
+				c := a =~ b
+			`,
+			want: `package main
+
+import (
+	_ "github.com/elk-language/elk"
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
+	"github.com/elk-language/elk/vm"
+)
+
+var _ = symbol.Value
+var _ = vm.New
+var _ = value.Truthy
+
+var sym0 = value.ToSymbol("main")
+var sym1 = value.ToSymbol("<main>")
+var regex0 = value.MustCompileRegex("(?<=h)e", bitfield.BitField8FromBitFlag(0))
+var sym2 = value.ToSymbol("=~")
+var fn_method0 vm.NativeFunction // Std::String.:=~
+
+func main() { // loc: <main>
+	thread := vm.New()
+	_ = thread
+	var callFrame *vm.CallFrame
+	_ = callFrame
+	var l0 value.String // var a: Std::String
+	_ = l0
+	var l1 *value.Regex // var b: Std::Regex
+	_ = l1
+	var l2 value.Bool // var c: Std::Bool
+	_ = l2
+	var t1 value.Value
+	_ = t1
+	var t2 []value.Value
+	_ = t2
+	var err value.Value
+	_ = err
+	var self value.Value
+	_ = self
+
+	self = value.Ref(value.GlobalObject)
+	fn_method0 = vm.MethodToFunc((value.StringClass).LookupMethod(sym2))
+
+	callFrame = thread.AddNativeCallFrame(sym0, sym1, 1)
+	defer thread.PopNativeCallFrame()
+	l0 = value.String("hello")
+	l1 = regex0
+	t2 = value.ResizeNativeArgs(t2, 3)
+	t2[0] = (l0).ToValue()
+	t2[1] = (l1).ToValue()
+	callFrame.SetNativeLineNumber(4)
+	t1, err = fn_method0(thread, t2) // receiver: Std::String, name: =~
+	if err.IsNotUndefined() {
+		thread.CaptureStackTrace()
+		thread.Panic(err)
+	}
+	l2 = value.ToBool(t1)
+}
 `,
 		},
 		"lax equal char": {
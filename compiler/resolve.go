@@ -2,11 +2,9 @@ package compiler
 
 import (
 	"fmt"
-	"regexp"
 	"strconv"
 
 	"github.com/elk-language/elk/parser/ast"
-	"github.com/elk-language/elk/regex"
 	"github.com/elk-language/elk/token"
 	"github.com/elk-language/elk/types"
 	"github.com/elk-language/elk/value"
@@ -136,17 +134,12 @@ func resolveMacroBoundary(n *ast.MacroBoundaryNode, checker types.Checker) value
 }
 
 func resolveUninterpolatedRegexLiteral(node *ast.UninterpolatedRegexLiteralNode) value.Value {
-	goRegexString, errList := regex.Transpile(node.Content, node.Flags)
-	if errList != nil {
-		return value.Undefined
-	}
-
-	re, err := regexp.Compile(goRegexString)
+	re, err := value.CompileRegex(node.Content, node.Flags)
 	if err != nil {
 		return value.Undefined
 	}
 
-	return value.Ref(value.NewRegex(*re, node.Content, node.Flags))
+	return value.Ref(re)
 }
 
 func resolveRangeLiteral(node *ast.RangeLiteralNode, checker types.Checker) value.Value {
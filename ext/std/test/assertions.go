@@ -282,7 +282,11 @@ func initAssertions(testModule *value.Module) {
 			argRegex := (*value.Regex)(args[1].Pointer())
 			argString := args[2].AsString()
 
-			if argRegex.MatchesString(argString.String()) {
+			matched, err := argRegex.MatchString(argString.String())
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			if matched {
 				return value.Nil, value.Undefined
 			}
 
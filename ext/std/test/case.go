@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"strings"
 	"time"
 
 	"github.com/elk-language/elk/position"
@@ -143,9 +144,11 @@ func (c *Case) Run(v *vm.Thread, events chan<- *ReportEvent, ctx context.Context
 		v.Stderr = prevStderr
 	}()
 
+	leakMark := vm.LeakMark()
 	caseReport, ok = c.runBeforeEach(startTime, caseReport, v, events, ctx)
 	if !ok {
 		c.runAfterEach(startTime, caseReport, v)
+		checkLeaks(caseReport, leakMark)
 		return caseReport
 	}
 
@@ -155,6 +158,7 @@ func (c *Case) Run(v *vm.Thread, events chan<- *ReportEvent, ctx context.Context
 	callCaseClosure(v, caseReport, startTime, c.Fn, ErrCase)
 
 	c.runAfterEach(startTime, caseReport, v)
+	checkLeaks(caseReport, leakMark)
 
 	if isDone(ctx) {
 		return nil
@@ -187,3 +191,45 @@ func (c *Case) runAfterEach(startTime time.Time, report *CaseReport, v *vm.Threa
 		}
 	}
 }
+
+// How long threads and promises created by a test
+// have to finish after the test is done.
+const leakGracePeriod = 200 * time.Millisecond
+
+// Fail the test when threads started with `go`
+// or promises created by it are still running.
+// Leaks are only tracked in debug builds.
+func checkLeaks(caseReport *CaseReport, mark uint64) {
+	deadline := time.Now().Add(leakGracePeriod)
+	leaks := vm.LeaksSince(mark)
+	for len(leaks) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		leaks = vm.LeaksSince(mark)
+	}
+	if len(leaks) == 0 {
+		return
+	}
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "test left %d running thread(s) or promise(s) behind:", len(leaks))
+	for _, leak := range leaks {
+		fmt.Fprintf(&message, "\n      %s", leak.Description)
+	}
+
+	stackTrace := &value.StackTrace{}
+	for _, leak := range leaks {
+		if leak.StackTrace != nil {
+			stackTrace = leak.StackTrace
+			break
+		}
+	}
+
+	caseReport.UpdateStatus(TEST_ERROR)
+	caseReport.RegisterErr(
+		Err{
+			Typ:        ErrLeak,
+			Err:        value.Ref(value.NewError(value.ErrorClass, message.String())),
+			StackTrace: stackTrace,
+		},
+	)
+}
@@ -27,6 +27,7 @@ const (
 	ErrBeforeEach
 	ErrAfterAll
 	ErrAfterEach
+	ErrLeak
 )
 
 func (e ErrTyp) String() string {
@@ -39,6 +40,7 @@ var errString = []string{
 	ErrBeforeEach: "before_each",
 	ErrAfterAll:   "after_all",
 	ErrAfterEach:  "after_each",
+	ErrLeak:       "leak",
 }
 
 type Err struct {
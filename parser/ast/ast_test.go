@@ -233,6 +233,48 @@ func TestSplice(t *testing.T) {
 				),
 			),
 		},
+		"splice logical expressions with non constant operands": {
+			node: NewLogicalExpressionNode(
+				L("main", S(P(0, 1, 1), P(5, 1, 6))),
+				T(L("main", S(P(2, 1, 3), P(3, 1, 4))), token.QUESTION_QUESTION),
+				NewPublicIdentifierNode(
+					L("main", S(P(0, 1, 1), P(0, 1, 1))),
+					"a",
+				),
+				NewIntLiteralNode(
+					L("main", S(P(5, 1, 6), P(5, 1, 6))),
+					"0",
+				),
+			),
+			loc: L("bar", S(P(92, 7, 10), P(115, 5, 32))),
+			want: NewLogicalExpressionNode(
+				LP(
+					"bar", S(P(92, 7, 10), P(115, 5, 32)),
+					L("main", S(P(0, 1, 1), P(5, 1, 6))),
+				),
+				T(
+					LP(
+						"bar", S(P(92, 7, 10), P(115, 5, 32)),
+						L("main", S(P(2, 1, 3), P(3, 1, 4))),
+					),
+					token.QUESTION_QUESTION,
+				),
+				NewPublicIdentifierNode(
+					LP(
+						"bar", S(P(92, 7, 10), P(115, 5, 32)),
+						L("main", S(P(0, 1, 1), P(0, 1, 1))),
+					),
+					"a",
+				),
+				NewIntLiteralNode(
+					LP(
+						"bar", S(P(92, 7, 10), P(115, 5, 32)),
+						L("main", S(P(5, 1, 6), P(5, 1, 6))),
+					),
+					"0",
+				),
+			),
+		},
 	}
 
 	for name, tc := range tests {
@@ -0,0 +1,316 @@
+// Package backtrack implements a backtracking regex engine
+// that supports features unavailable in Go regexes
+// like lookarounds, backreferences, atomic groups
+// and possessive quantifiers.
+package backtrack
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/position/diagnostic"
+	"github.com/elk-language/elk/regex/parser"
+	"github.com/elk-language/elk/regex/parser/ast"
+)
+
+// The default maximum number of steps
+// a single operation can take before it gets aborted.
+// Protects against catastrophic backtracking.
+const DefaultStepLimit = 1_000_000
+
+// Returned when an operation exceeds the step limit.
+var ErrStepLimitExceeded = errors.New("regex step limit exceeded, the pattern backtracks too much")
+
+// A regex compiled for the backtracking engine.
+type Program struct {
+	root  node
+	names []string // names of capture groups, the first element represents the entire match
+	// Maximum number of steps a single operation can take,
+	// finding all matches shares one budget between its searches.
+	StepLimit int
+}
+
+// Compile an Elk regex string.
+func Compile(src string, flags bitfield.BitField8) (*Program, diagnostic.DiagnosticList) {
+	node, err := parser.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return CompileNode(node, flags)
+}
+
+// Whether the parsed regex uses features that only
+// the backtracking engine supports.
+func IsRequired(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.LookaheadNode, *ast.LookbehindNode, *ast.BackreferenceNode,
+		*ast.AtomicGroupNode, *ast.PossessiveQuantifierNode:
+		return true
+	case *ast.ConcatenationNode:
+		for _, element := range n.Elements {
+			if IsRequired(element) {
+				return true
+			}
+		}
+		return false
+	case *ast.UnionNode:
+		return IsRequired(n.Left) || IsRequired(n.Right)
+	case *ast.GroupNode:
+		return IsRequired(n.Regex)
+	case *ast.ZeroOrOneQuantifierNode:
+		return IsRequired(n.Regex)
+	case *ast.ZeroOrMoreQuantifierNode:
+		return IsRequired(n.Regex)
+	case *ast.OneOrMoreQuantifierNode:
+		return IsRequired(n.Regex)
+	case *ast.NQuantifierNode:
+		return IsRequired(n.Regex)
+	case *ast.NMQuantifierNode:
+		return IsRequired(n.Regex)
+	default:
+		return false
+	}
+}
+
+// Compile a parsed Elk regex.
+func CompileNode(node ast.Node, flags bitfield.BitField8) (*Program, diagnostic.DiagnosticList) {
+	c := &compiler{
+		Flags: flags,
+		names: []string{""},
+	}
+	root := c.compileNode(node)
+	c.resolveBackreferences()
+	if c.Errors != nil {
+		return nil, c.Errors
+	}
+
+	return &Program{
+		root:      root,
+		names:     c.names,
+		StepLimit: DefaultStepLimit,
+	}, nil
+}
+
+// Compile an Elk regex string, panics on error.
+func MustCompile(src string, flags bitfield.BitField8) *Program {
+	p, err := Compile(src, flags)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Returns the number of capture groups.
+func (p *Program) NumSubexp() int {
+	return len(p.names) - 1
+}
+
+// Returns the names of capture groups,
+// the first element represents the entire match
+// and unnamed groups have empty names.
+func (p *Program) SubexpNames() []string {
+	return p.names
+}
+
+// Returns the index of the capture group with the given name
+// or -1 when there is no such group.
+func (p *Program) SubexpIndex(name string) int {
+	if name == "" {
+		return -1
+	}
+	for i, groupName := range p.names {
+		if groupName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *Program) newMachine(input string) *machine {
+	return &machine{
+		input:    input,
+		captures: make([]int, 2*len(p.names)),
+		limit:    p.StepLimit,
+	}
+}
+
+// Try to match the regex starting exactly at `pos`.
+func (p *Program) matchAt(m *machine, pos int) bool {
+	for i := range m.captures {
+		m.captures[i] = -1
+	}
+	return p.root.match(m, pos, func(end int) bool {
+		m.captures[0] = pos
+		m.captures[1] = end
+		return true
+	})
+}
+
+// Search for the leftmost match starting at `pos`.
+// Returns the byte offsets of the match and its capture groups
+// or nil when there is no match.
+// Steps are counted on the machine, so successive searches
+// draw from the same budget.
+func (p *Program) search(m *machine, pos int) ([]int, error) {
+	for {
+		if p.matchAt(m, pos) {
+			return slices.Clone(m.captures), nil
+		}
+		if m.exceeded() {
+			return nil, ErrStepLimitExceeded
+		}
+		if pos >= len(m.input) {
+			return nil, nil
+		}
+		_, size := utf8.DecodeRuneInString(m.input[pos:])
+		pos += size
+	}
+}
+
+// Reports whether the string contains a match of the regex.
+func (p *Program) MatchString(s string) (bool, error) {
+	match, err := p.search(p.newMachine(s), 0)
+	return match != nil, err
+}
+
+// Returns the byte offsets of the leftmost match and its capture groups
+// in pairs like `regexp.Regexp.FindStringSubmatchIndex`.
+// Returns nil when there is no match.
+func (p *Program) FindStringSubmatchIndex(s string) ([]int, error) {
+	return p.search(p.newMachine(s), 0)
+}
+
+// Returns the byte offsets of successive non-overlapping matches
+// like `regexp.Regexp.FindAllStringSubmatchIndex`.
+// n limits the number of matches, -1 means no limit.
+func (p *Program) FindAllStringSubmatchIndex(s string, n int) ([][]int, error) {
+	if n < 0 {
+		n = len(s) + 1
+	}
+
+	var result [][]int
+	m := p.newMachine(s)
+	prevMatchEnd := -1
+	for pos := 0; len(result) < n && pos <= len(s); {
+		match, err := p.search(m, pos)
+		if err != nil {
+			return nil, err
+		}
+		if match == nil {
+			break
+		}
+
+		accept := true
+		if match[1] == pos {
+			// an empty match right after a previous match is ignored
+			if match[0] == prevMatchEnd {
+				accept = false
+			}
+			if pos < len(s) {
+				_, size := utf8.DecodeRuneInString(s[pos:])
+				pos += size
+			} else {
+				pos++
+			}
+		} else {
+			pos = match[1]
+		}
+		prevMatchEnd = match[1]
+
+		if accept {
+			result = append(result, match)
+		}
+	}
+
+	return result, nil
+}
+
+// Append the template to dst with variables like `$1` or `${name}`
+// replaced by the corresponding capture groups of the match
+// like `regexp.Regexp.ExpandString`.
+func (p *Program) ExpandString(dst []byte, template string, src string, match []int) []byte {
+	for len(template) > 0 {
+		before, after, ok := strings.Cut(template, "$")
+		dst = append(dst, before...)
+		if !ok {
+			break
+		}
+		template = after
+		if len(template) > 0 && template[0] == '$' {
+			// $$
+			dst = append(dst, '$')
+			template = template[1:]
+			continue
+		}
+
+		name, num, rest, ok := extractTemplateVariable(template)
+		if !ok {
+			// malformed, treat $ as raw text
+			dst = append(dst, '$')
+			continue
+		}
+		template = rest
+		if num < 0 {
+			num = p.SubexpIndex(name)
+		}
+		if num >= 0 && 2*num+1 < len(match) && match[2*num] >= 0 {
+			dst = append(dst, src[match[2*num]:match[2*num+1]]...)
+		}
+	}
+	return dst
+}
+
+// Extract the name or number of a variable
+// from the beginning of a template like `name`, `1` or `{name}`.
+// num is -1 when the variable is a name.
+func extractTemplateVariable(template string) (name string, num int, rest string, ok bool) {
+	if len(template) == 0 {
+		return "", -1, "", false
+	}
+
+	brace := template[0] == '{'
+	if brace {
+		template = template[1:]
+	}
+	i := 0
+	for i < len(template) {
+		r, size := utf8.DecodeRuneInString(template[i:])
+		if !isTemplateNameChar(r) {
+			break
+		}
+		i += size
+	}
+	if i == 0 {
+		return "", -1, "", false
+	}
+	name = template[:i]
+	if brace {
+		if i >= len(template) || template[i] != '}' {
+			return "", -1, "", false
+		}
+		i++
+	}
+	rest = template[i:]
+
+	num = 0
+	for _, char := range name {
+		if char < '0' || char > '9' || num >= 1e8 {
+			return name, -1, rest, true
+		}
+		num = num*10 + int(char) - '0'
+	}
+	// disallow leading zeros
+	if name[0] == '0' && len(name) > 1 {
+		return name, -1, rest, true
+	}
+	return name, num, rest, true
+}
+
+func isTemplateNameChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,285 @@
+package backtrack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/position"
+	"github.com/elk-language/elk/position/diagnostic"
+	"github.com/elk-language/elk/regex/flag"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Create a new source position in tests.
+var P = position.New
+
+// Create a new source location in tests.
+func L(filename string, startPos, endPos *position.Position) *position.Location {
+	return position.NewLocation(filename, position.NewSpan(startPos, endPos))
+}
+
+func TestFindStringSubmatchIndex(t *testing.T) {
+	tests := map[string]struct {
+		regex string
+		flags bitfield.BitField8
+		input string
+		want  []int
+	}{
+		"literal": {
+			regex: `foo`,
+			input: "a foo",
+			want:  []int{2, 5},
+		},
+		"no match": {
+			regex: `foo`,
+			input: "bar",
+			want:  nil,
+		},
+		"greedy quantifier with groups": {
+			regex: `(\w+)@(\w+)`,
+			input: "mail: john@example",
+			want:  []int{6, 18, 6, 10, 11, 18},
+		},
+		"lazy quantifier": {
+			regex: `<.+?>`,
+			input: "<a><b>",
+			want:  []int{0, 3},
+		},
+		"ungreedy flag": {
+			regex: `<.+>`,
+			flags: bitfield.BitField8FromBitFlag(flag.UngreedyFlag),
+			input: "<a><b>",
+			want:  []int{0, 3},
+		},
+		"positive lookahead": {
+			regex: `\w+(?=!)`,
+			input: "hey you!",
+			want:  []int{4, 7},
+		},
+		"negative lookahead": {
+			regex: `\b\d+\b(?!%)`,
+			input: "50% of 120",
+			want:  []int{7, 10},
+		},
+		"positive lookbehind": {
+			regex: `(?<=\$)\d+`,
+			input: "cost: $42",
+			want:  []int{7, 9},
+		},
+		"negative lookbehind": {
+			regex: `(?<!\$)\b\d+`,
+			input: "$42 or 17",
+			want:  []int{7, 9},
+		},
+		"variable length lookbehind": {
+			regex: `(?<=ab+)c`,
+			input: "abbbc",
+			want:  []int{4, 5},
+		},
+		"numeric backreference": {
+			regex: `(\w)\1`,
+			input: "abccd",
+			want:  []int{2, 4, 2, 3},
+		},
+		"named backreference": {
+			regex: `(?<quote>['"]).*?\k<quote>`,
+			input: `say "it's" now`,
+			want:  []int{4, 10, 4, 5},
+		},
+		"case insensitive backreference": {
+			regex: `(a)\1`,
+			flags: bitfield.BitField8FromBitFlag(flag.CaseInsensitiveFlag),
+			input: "aA",
+			want:  []int{0, 2, 0, 1},
+		},
+		"atomic group does not backtrack": {
+			regex: `(?>a+)ab`,
+			input: "aaab",
+			want:  nil,
+		},
+		"possessive quantifier does not backtrack": {
+			regex: `"[^"]*+"`,
+			input: `x "foo" y`,
+			want:  []int{2, 7},
+		},
+		"possessive quantifier fails": {
+			regex: `a++a`,
+			input: "aaaa",
+			want:  nil,
+		},
+		"alternation": {
+			regex: `cat|dog`,
+			input: "hotdog",
+			want:  []int{3, 6},
+		},
+		"unicode not word char in char class": {
+			regex: `[\W\d]+`,
+			input: "ab- 12cd",
+			want:  []int{2, 6},
+		},
+		"unicode not whitespace in char class": {
+			regex: `(?=\w)[\S]+`,
+			input: "  zażółć ",
+			want:  []int{2, 12},
+		},
+		"multiline anchors": {
+			regex: `^b$`,
+			flags: bitfield.BitField8FromBitFlag(flag.MultilineFlag),
+			input: "a\nb\nc",
+			want:  []int{2, 3},
+		},
+		"extended flag": {
+			regex: "(?=a) a b # comment\n c",
+			flags: bitfield.BitField8FromBitFlag(flag.ExtendedFlag),
+			input: "abc",
+			want:  []int{0, 3},
+		},
+		"case insensitive char class": {
+			regex: `(?=.)[a-c]+`,
+			flags: bitfield.BitField8FromBitFlag(flag.CaseInsensitiveFlag),
+			input: "xABcd",
+			want:  []int{1, 4},
+		},
+		"counted repetition": {
+			regex: `(?=\d)\d{2,3}`,
+			input: "12345",
+			want:  []int{0, 3},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, errList := Compile(tc.regex, tc.flags)
+			if errList != nil {
+				t.Fatal(errList)
+			}
+			got, err := p.FindStringSubmatchIndex(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestFindAllStringSubmatchIndex(t *testing.T) {
+	tests := map[string]struct {
+		regex string
+		input string
+		n     int
+		want  [][]int
+	}{
+		"all matches": {
+			regex: `\d+(?=px)`,
+			input: "10px 20em 30px",
+			n:     -1,
+			want:  [][]int{{0, 2}, {10, 12}},
+		},
+		"limited": {
+			regex: `(?<=-)\w`,
+			input: "-a-b-c",
+			n:     2,
+			want:  [][]int{{1, 2}, {3, 4}},
+		},
+		"empty matches": {
+			regex: `(?=b)|b`,
+			input: "abb",
+			n:     -1,
+			want:  [][]int{{1, 1}, {2, 2}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := MustCompile(tc.regex, bitfield.BitField8{})
+			got, err := p.FindAllStringSubmatchIndex(tc.input, tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestStepLimit(t *testing.T) {
+	p := MustCompile(`(?=x)|(a+)+b`, bitfield.BitField8{})
+	_, err := p.MatchString("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	if err != ErrStepLimitExceeded {
+		t.Fatalf("expected step limit error, got: %#v", err)
+	}
+
+	p.StepLimit = 10
+	_, err = p.MatchString("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaab")
+	if err != ErrStepLimitExceeded {
+		t.Fatalf("expected step limit error, got: %#v", err)
+	}
+}
+
+func TestStepLimitSharedBetweenMatches(t *testing.T) {
+	p := MustCompile(`(?=a)a`, bitfield.BitField8{})
+	p.StepLimit = 50
+	input := strings.Repeat("a", 100)
+
+	if _, err := p.FindStringSubmatchIndex(input); err != nil {
+		t.Fatalf("unexpected error in a single search: %#v", err)
+	}
+	_, err := p.FindAllStringSubmatchIndex(input, -1)
+	if err != ErrStepLimitExceeded {
+		t.Fatalf("expected step limit error, got: %#v", err)
+	}
+}
+
+func TestExpandString(t *testing.T) {
+	p := MustCompile(`(?<year>\d{4})-(\d{2})(?=-)`, bitfield.BitField8{})
+	src := "2024-05-17"
+	match, err := p.FindStringSubmatchIndex(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(p.ExpandString(nil, "$2/${year} $$ $foo $", src, match))
+	want := "05/2024 $  $"
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := map[string]struct {
+		regex string
+		err   diagnostic.DiagnosticList
+	}{
+		"undefined named group": {
+			regex: `(a)\k<foo>`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(3, 1, 4), P(9, 1, 10)), "undefined capture group: foo"),
+			},
+		},
+		"undefined numbered group": {
+			regex: `(a)\k<2>`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(3, 1, 4), P(7, 1, 8)), "undefined capture group: 2"),
+			},
+		},
+		"invalid unicode char class": {
+			regex: `\p{Foo}`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(0, 1, 1), P(5, 1, 6)), "invalid unicode char class: Foo"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Compile(tc.regex, bitfield.BitField8{})
+			if diff := cmp.Diff(tc.err, err); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package backtrack
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+func negate(predicate func(rune) bool) func(rune) bool {
+	return func(r rune) bool { return !predicate(r) }
+}
+
+// Checks whether a and b are equal
+// using simple unicode case folding.
+func foldEqual(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for f := unicode.SimpleFold(a); f != a; f = unicode.SimpleFold(f) {
+		if f == b {
+			return true
+		}
+	}
+	return false
+}
+
+func anyChar(rune) bool {
+	return true
+}
+
+func anyCharExceptNewline(r rune) bool {
+	return r != '\n'
+}
+
+func isASCIIWordChar(r rune) bool {
+	return r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r == '_'
+}
+
+// \w
+func wordCharPredicate(ascii bool) func(rune) bool {
+	if ascii {
+		return isASCIIWordChar
+	}
+	return func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Nd, r) || unicode.Is(unicode.Pc, r)
+	}
+}
+
+// \d
+func digitCharPredicate(ascii bool) func(rune) bool {
+	if ascii {
+		return func(r rune) bool { return r >= '0' && r <= '9' }
+	}
+	return func(r rune) bool { return unicode.Is(unicode.Nd, r) }
+}
+
+// \s
+func whitespaceCharPredicate(ascii bool) func(rune) bool {
+	if ascii {
+		return func(r rune) bool {
+			switch r {
+			case '\t', '\n', '\f', '\r', ' ':
+				return true
+			default:
+				return false
+			}
+		}
+	}
+	return func(r rune) bool {
+		switch r {
+		case '\t', '\n', '\v', '\f', '\r', ' ', '\u0085':
+			return true
+		default:
+			return unicode.Is(unicode.Z, r)
+		}
+	}
+}
+
+// \h
+func horizontalWhitespaceCharPredicate(ascii bool) func(rune) bool {
+	if ascii {
+		return func(r rune) bool { return r == '\t' || r == ' ' }
+	}
+	return func(r rune) bool { return r == '\t' || unicode.Is(unicode.Zs, r) }
+}
+
+// \v
+func verticalWhitespaceCharPredicate(ascii bool) func(rune) bool {
+	if ascii {
+		return func(r rune) bool { return r >= '\n' && r <= '\r' }
+	}
+	return func(r rune) bool {
+		switch r {
+		case '\n', '\v', '\f', '\r', '\u0085', '\u2028', '\u2029':
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+// ASCII char classes like `[:alpha:]`
+var namedCharClasses = map[string]func(rune) bool{
+	"alnum": func(r rune) bool {
+		return r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z'
+	},
+	"alpha": func(r rune) bool { return r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' },
+	"ascii": func(r rune) bool { return r <= 0x7f },
+	"blank": func(r rune) bool { return r == '\t' || r == ' ' },
+	"cntrl": func(r rune) bool { return r <= 0x1f || r == 0x7f },
+	"digit": func(r rune) bool { return r >= '0' && r <= '9' },
+	"graph": func(r rune) bool { return r >= '!' && r <= '~' },
+	"lower": func(r rune) bool { return r >= 'a' && r <= 'z' },
+	"print": func(r rune) bool { return r >= ' ' && r <= '~' },
+	"punct": func(r rune) bool {
+		return r >= '!' && r <= '/' || r >= ':' && r <= '@' || r >= '[' && r <= '`' || r >= '{' && r <= '~'
+	},
+	"space": func(r rune) bool {
+		return r == ' ' || r >= '\t' && r <= '\r'
+	},
+	"upper": func(r rune) bool { return r >= 'A' && r <= 'Z' },
+	"word":  isASCIIWordChar,
+	"xdigit": func(r rune) bool {
+		return r >= '0' && r <= '9' || r >= 'A' && r <= 'F' || r >= 'a' && r <= 'f'
+	},
+}
+
+// Returns the predicate of a unicode char class like `\pL` or `\p{Greek}`
+// or nil when the class does not exist.
+func unicodeCharClass(name string) func(rune) bool {
+	if name == "Any" {
+		return anyChar
+	}
+	if table, ok := unicode.Categories[name]; ok {
+		return func(r rune) bool { return unicode.Is(table, r) }
+	}
+	if table, ok := unicode.Scripts[name]; ok {
+		return func(r rune) bool { return unicode.Is(table, r) }
+	}
+	return nil
+}
+
+func isStartOfText(input string, pos int) bool {
+	return pos == 0
+}
+
+func isEndOfText(input string, pos int) bool {
+	return pos == len(input)
+}
+
+func isStartOfLine(input string, pos int) bool {
+	return pos == 0 || input[pos-1] == '\n'
+}
+
+func isEndOfLine(input string, pos int) bool {
+	return pos == len(input) || input[pos] == '\n'
+}
+
+// Word boundaries use ASCII word chars like Go regexes.
+func isWordBoundary(input string, pos int) bool {
+	var before, after bool
+	if pos > 0 {
+		r, _ := utf8.DecodeLastRuneInString(input[:pos])
+		before = isASCIIWordChar(r)
+	}
+	if pos < len(input) {
+		r, _ := utf8.DecodeRuneInString(input[pos:])
+		after = isASCIIWordChar(r)
+	}
+	return before != after
+}
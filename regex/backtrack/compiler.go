@@ -0,0 +1,474 @@
+package backtrack
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/position"
+	"github.com/elk-language/elk/position/diagnostic"
+	"github.com/elk-language/elk/regex/flag"
+	"github.com/elk-language/elk/regex/parser/ast"
+)
+
+// Holds the state of the compiler.
+type compiler struct {
+	Errors diagnostic.DiagnosticList
+	Flags  bitfield.BitField8
+	names  []string // names of capture groups, the first element represents the entire match
+	// backreferences that get resolved
+	// after all capture groups are known
+	backreferences []unresolvedBackreference
+}
+
+type unresolvedBackreference struct {
+	node   *backreferenceNode
+	source *ast.BackreferenceNode
+}
+
+// Create a new location struct with the given position.
+func (c *compiler) newLocation(span *position.Span) *position.Location {
+	return position.NewLocation("regex", span)
+}
+
+func (c *compiler) errorf(span *position.Span, format string, args ...any) {
+	c.Errors.AddFailure(fmt.Sprintf(format, args...), c.newLocation(span))
+}
+
+func (c *compiler) resolveBackreferences() {
+	for _, ref := range c.backreferences {
+		value := ref.source.Value
+		if index, err := strconv.Atoi(value); err == nil {
+			if index < 1 || index >= len(c.names) {
+				c.errorf(ref.source.Span(), "undefined capture group: %s", value)
+				continue
+			}
+			ref.node.index = index
+			continue
+		}
+
+		index := -1
+		for i, name := range c.names {
+			if name == value {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			c.errorf(ref.source.Span(), "undefined capture group: %s", value)
+			continue
+		}
+		ref.node.index = index
+	}
+}
+
+func (c *compiler) compileNode(n ast.Node) node {
+	switch n := n.(type) {
+	case *ast.ConcatenationNode:
+		return c.concatenation(n)
+	case *ast.UnionNode:
+		return &alternationNode{
+			alternatives: []node{c.compileNode(n.Left), c.compileNode(n.Right)},
+		}
+	case *ast.GroupNode:
+		return c.group(n)
+	case *ast.AtomicGroupNode:
+		return &atomicNode{regex: c.compileNode(n.Regex)}
+	case *ast.LookaheadNode:
+		return &lookaroundNode{
+			regex:   c.compileNode(n.Regex),
+			negated: n.Negated,
+		}
+	case *ast.LookbehindNode:
+		regex := c.compileNode(n.Regex)
+		return &lookaroundNode{
+			regex:    regex,
+			behind:   true,
+			negated:  n.Negated,
+			maxWidth: maxWidth(regex),
+		}
+	case *ast.BackreferenceNode:
+		ref := &backreferenceNode{fold: c.Flags.HasFlag(flag.CaseInsensitiveFlag)}
+		c.backreferences = append(c.backreferences, unresolvedBackreference{node: ref, source: n})
+		return ref
+	case *ast.ZeroOrOneQuantifierNode:
+		return c.repeat(n.Regex, 0, 1, n.Alt)
+	case *ast.ZeroOrMoreQuantifierNode:
+		return c.repeat(n.Regex, 0, -1, n.Alt)
+	case *ast.OneOrMoreQuantifierNode:
+		return c.repeat(n.Regex, 1, -1, n.Alt)
+	case *ast.NQuantifierNode:
+		count := c.repeatCount(n.N, n)
+		return c.repeat(n.Regex, count, count, n.Alt)
+	case *ast.NMQuantifierNode:
+		min := c.repeatCount(n.N, n)
+		max := -1
+		if n.M != "" {
+			max = c.repeatCount(n.M, n)
+			if max < min {
+				c.errorf(n.Span(), "invalid repeat count")
+			}
+		}
+		return c.repeat(n.Regex, min, max, n.Alt)
+	case *ast.PossessiveQuantifierNode:
+		return &atomicNode{regex: c.compileNode(n.Quantifier)}
+	case *ast.QuotedTextNode:
+		elements := make([]node, 0, len(n.Value))
+		for _, char := range n.Value {
+			elements = append(elements, c.char(char))
+		}
+		return &sequenceNode{elements: elements}
+	case *ast.CharNode:
+		if c.Flags.HasFlag(flag.ExtendedFlag) && unicode.IsSpace(n.Value) {
+			return &emptyNode{}
+		}
+		return c.char(n.Value)
+	case *ast.AnyCharClassNode:
+		if c.Flags.HasFlag(flag.DotAllFlag) {
+			return &runeNode{matches: anyChar}
+		}
+		return &runeNode{matches: anyCharExceptNewline}
+	case *ast.CharClassNode:
+		return c.charClass(n)
+	case *ast.StartOfStringAnchorNode:
+		if c.Flags.HasFlag(flag.MultilineFlag) {
+			return &assertionNode{matches: isStartOfLine}
+		}
+		return &assertionNode{matches: isStartOfText}
+	case *ast.EndOfStringAnchorNode:
+		if c.Flags.HasFlag(flag.MultilineFlag) {
+			return &assertionNode{matches: isEndOfLine}
+		}
+		return &assertionNode{matches: isEndOfText}
+	case *ast.AbsoluteStartOfStringAnchorNode:
+		return &assertionNode{matches: isStartOfText}
+	case *ast.AbsoluteEndOfStringAnchorNode:
+		return &assertionNode{matches: isEndOfText}
+	case *ast.WordBoundaryAnchorNode:
+		return &assertionNode{matches: isWordBoundary}
+	case *ast.NotWordBoundaryAnchorNode:
+		return &assertionNode{
+			matches: func(input string, pos int) bool { return !isWordBoundary(input, pos) },
+		}
+	case nil:
+		return &emptyNode{}
+	}
+
+	if element, ok := n.(ast.CharClassElementNode); ok {
+		predicate := c.charClassElement(element)
+		if predicate == nil {
+			return &emptyNode{}
+		}
+		return &runeNode{matches: c.fold(predicate)}
+	}
+
+	c.errorf(n.Span(), "compilation of this node has not been implemented: %T", n)
+	return &emptyNode{}
+}
+
+func (c *compiler) concatenation(n *ast.ConcatenationNode) node {
+	var inComment bool
+	elements := make([]node, 0, len(n.Elements))
+
+	for _, element := range n.Elements {
+		if c.Flags.HasFlag(flag.ExtendedFlag) {
+			if inComment {
+				if ch, ok := element.(*ast.CharNode); ok && ch.Value == '\n' {
+					inComment = false
+				}
+				continue
+			}
+			if ch, ok := element.(*ast.CharNode); ok && ch.Value == '#' {
+				inComment = true
+				continue
+			}
+		}
+		elements = append(elements, c.compileNode(element))
+	}
+
+	return &sequenceNode{elements: elements}
+}
+
+func (c *compiler) group(n *ast.GroupNode) node {
+	originalFlags := c.Flags
+	for _, fl := range flag.Flags {
+		if n.SetFlags.HasFlag(fl) {
+			c.Flags.SetFlag(fl)
+		}
+		if n.UnsetFlags.HasFlag(fl) {
+			c.Flags.UnsetFlag(fl)
+		}
+	}
+	if n.Regex == nil {
+		// flags apply to the rest of the enclosing group
+		return &emptyNode{}
+	}
+
+	capturing := len(n.Name) > 0 || !n.NonCapturing && !n.IsAnyFlagSet()
+	var index int
+	if capturing {
+		index = len(c.names)
+		if len(n.Name) > 0 && c.captureIndex(n.Name) != -1 {
+			c.errorf(n.Span(), "duplicate capture group name: %s", n.Name)
+		}
+		c.names = append(c.names, n.Name)
+	}
+
+	content := c.compileNode(n.Regex)
+	c.Flags = originalFlags
+	if !capturing {
+		return content
+	}
+	return &captureNode{index: index, regex: content}
+}
+
+func (c *compiler) captureIndex(name string) int {
+	for i, groupName := range c.names {
+		if groupName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// The maximum repeat count, the same as in Go regexes.
+const maxRepeatCount = 1000
+
+func (c *compiler) repeatCount(digits string, n ast.Node) int {
+	if digits == "" {
+		return 0
+	}
+	count, err := strconv.Atoi(digits)
+	if err != nil || count > maxRepeatCount {
+		c.errorf(n.Span(), "invalid repeat count")
+		return 0
+	}
+	return count
+}
+
+func (c *compiler) repeat(regex ast.Node, min, max int, lazy bool) node {
+	if c.Flags.HasFlag(flag.UngreedyFlag) {
+		lazy = !lazy
+	}
+	return &repeatNode{
+		regex: c.compileNode(regex),
+		min:   min,
+		max:   max,
+		lazy:  lazy,
+	}
+}
+
+func (c *compiler) char(char rune) node {
+	if c.Flags.HasFlag(flag.CaseInsensitiveFlag) {
+		return &runeNode{matches: func(r rune) bool { return foldEqual(char, r) }}
+	}
+	return &runeNode{matches: func(r rune) bool { return r == char }}
+}
+
+// Make the predicate case insensitive
+// when the `i` flag is enabled.
+func (c *compiler) fold(predicate func(rune) bool) func(rune) bool {
+	if !c.Flags.HasFlag(flag.CaseInsensitiveFlag) {
+		return predicate
+	}
+	return func(r rune) bool {
+		if predicate(r) {
+			return true
+		}
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if predicate(f) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (c *compiler) charClass(n *ast.CharClassNode) node {
+	predicates := make([]func(rune) bool, 0, len(n.Elements))
+	for _, element := range n.Elements {
+		predicate := c.charClassElement(element)
+		if predicate != nil {
+			predicates = append(predicates, predicate)
+		}
+	}
+
+	matches := c.fold(func(r rune) bool {
+		for _, predicate := range predicates {
+			if predicate(r) {
+				return true
+			}
+		}
+		return false
+	})
+	if n.Negated {
+		return &runeNode{matches: func(r rune) bool { return !matches(r) }}
+	}
+	return &runeNode{matches: matches}
+}
+
+func (c *compiler) charClassElement(n ast.CharClassElementNode) func(rune) bool {
+	ascii := c.Flags.HasFlag(flag.ASCIIFlag)
+
+	switch n := n.(type) {
+	case *ast.CharRangeNode:
+		from, fromOk := c.charValue(n.Left)
+		to, toOk := c.charValue(n.Right)
+		if !fromOk || !toOk {
+			return nil
+		}
+		if from > to {
+			c.errorf(n.Span(), "invalid char range")
+			return nil
+		}
+		return func(r rune) bool { return r >= from && r <= to }
+	case *ast.NamedCharClassNode:
+		predicate, ok := namedCharClasses[n.Name]
+		if !ok {
+			c.errorf(n.Span(), "invalid named char class: %s", n.Name)
+			return nil
+		}
+		if n.Negated {
+			return negate(predicate)
+		}
+		return predicate
+	case *ast.UnicodeCharClassNode:
+		predicate := unicodeCharClass(n.Value)
+		if predicate == nil {
+			c.errorf(n.Span(), "invalid unicode char class: %s", n.Value)
+			return nil
+		}
+		if n.Negated {
+			return negate(predicate)
+		}
+		return predicate
+	case *ast.WordCharClassNode:
+		return wordCharPredicate(ascii)
+	case *ast.NotWordCharClassNode:
+		return negate(wordCharPredicate(ascii))
+	case *ast.DigitCharClassNode:
+		return digitCharPredicate(ascii)
+	case *ast.NotDigitCharClassNode:
+		return negate(digitCharPredicate(ascii))
+	case *ast.WhitespaceCharClassNode:
+		return whitespaceCharPredicate(ascii)
+	case *ast.NotWhitespaceCharClassNode:
+		return negate(whitespaceCharPredicate(ascii))
+	case *ast.HorizontalWhitespaceCharClassNode:
+		return horizontalWhitespaceCharPredicate(ascii)
+	case *ast.NotHorizontalWhitespaceCharClassNode:
+		return negate(horizontalWhitespaceCharPredicate(ascii))
+	case *ast.VerticalWhitespaceCharClassNode:
+		return verticalWhitespaceCharPredicate(ascii)
+	case *ast.NotVerticalWhitespaceCharClassNode:
+		return negate(verticalWhitespaceCharPredicate(ascii))
+	}
+
+	char, ok := c.charValue(n)
+	if !ok {
+		return nil
+	}
+	return func(r rune) bool { return r == char }
+}
+
+// Returns the char represented by a char or an escape.
+func (c *compiler) charValue(n ast.Node) (rune, bool) {
+	switch n := n.(type) {
+	case *ast.CharNode:
+		return n.Value, true
+	case *ast.MetaCharEscapeNode:
+		return n.Value, true
+	case *ast.CaretEscapeNode:
+		return rune(asciiLetterIndex(n.Value)), true
+	case *ast.UnicodeEscapeNode:
+		return c.parseChar(n.Value, 16, n)
+	case *ast.HexEscapeNode:
+		return c.parseChar(n.Value, 16, n)
+	case *ast.OctalEscapeNode:
+		return c.parseChar(n.Value, 8, n)
+	case *ast.BellEscapeNode:
+		return '\a', true
+	case *ast.FormFeedEscapeNode:
+		return '\f', true
+	case *ast.TabEscapeNode:
+		return '\t', true
+	case *ast.NewlineEscapeNode:
+		return '\n', true
+	case *ast.CarriageReturnEscapeNode:
+		return '\r', true
+	default:
+		c.errorf(n.Span(), "compilation of this node has not been implemented: %T", n)
+		return 0, false
+	}
+}
+
+func (c *compiler) parseChar(digits string, base int, n ast.Node) (rune, bool) {
+	value, err := strconv.ParseUint(digits, base, 32)
+	if err != nil || value > unicode.MaxRune {
+		c.errorf(n.Span(), "invalid escape sequence")
+		return 0, false
+	}
+	return rune(value), true
+}
+
+func asciiLetterIndex(char rune) int {
+	if char >= 'A' && char <= 'Z' {
+		return int(char - 'A' + 1)
+	}
+
+	if char >= 'a' && char <= 'z' {
+		return int(char - 'a' + 1)
+	}
+
+	panic(fmt.Sprintf("char is not an ASCII letter: %c", char))
+}
+
+// Returns the maximum number of chars
+// that can be matched by the node
+// or -1 when it is unbounded.
+func maxWidth(n node) int {
+	switch n := n.(type) {
+	case *runeNode:
+		return 1
+	case *emptyNode, *assertionNode, *lookaroundNode:
+		return 0
+	case *sequenceNode:
+		var sum int
+		for _, element := range n.elements {
+			width := maxWidth(element)
+			if width == -1 {
+				return -1
+			}
+			sum += width
+		}
+		return sum
+	case *alternationNode:
+		var max int
+		for _, alternative := range n.alternatives {
+			width := maxWidth(alternative)
+			if width == -1 {
+				return -1
+			}
+			if width > max {
+				max = width
+			}
+		}
+		return max
+	case *captureNode:
+		return maxWidth(n.regex)
+	case *atomicNode:
+		return maxWidth(n.regex)
+	case *repeatNode:
+		if n.max == -1 {
+			return -1
+		}
+		width := maxWidth(n.regex)
+		if width == -1 {
+			return -1
+		}
+		return width * n.max
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,370 @@
+package backtrack
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// Holds the state of a single search.
+type machine struct {
+	input    string
+	captures []int
+	steps    int
+	limit    int
+}
+
+// Count a step of the search.
+// Returns false when the step limit has been exceeded.
+func (m *machine) step() bool {
+	m.steps++
+	return m.steps <= m.limit
+}
+
+// Whether the step limit has been exceeded.
+func (m *machine) exceeded() bool {
+	return m.steps > m.limit
+}
+
+func (m *machine) saveCaptures() []int {
+	return slices.Clone(m.captures)
+}
+
+func (m *machine) restoreCaptures(saved []int) {
+	copy(m.captures, saved)
+}
+
+// Continuation called with the end position of a successful match
+// of a node. Returns true when the rest of the regex has matched.
+type continuation func(pos int) bool
+
+// Represents a compiled element of a regex.
+type node interface {
+	// Try to match the node at `pos` and call `k`
+	// with every possible end position until it returns true.
+	match(m *machine, pos int, k continuation) bool
+}
+
+// Matches the empty string.
+type emptyNode struct{}
+
+func (*emptyNode) match(m *machine, pos int, k continuation) bool {
+	if !m.step() {
+		return false
+	}
+	return k(pos)
+}
+
+// Matches a single char that satisfies a predicate.
+type runeNode struct {
+	matches func(r rune) bool
+}
+
+func (n *runeNode) match(m *machine, pos int, k continuation) bool {
+	if !m.step() {
+		return false
+	}
+	size, ok := n.matchRune(m.input, pos)
+	if !ok {
+		return false
+	}
+	return k(pos + size)
+}
+
+// Returns the byte size of the char at `pos`
+// and whether it satisfies the predicate.
+func (n *runeNode) matchRune(input string, pos int) (int, bool) {
+	if pos >= len(input) {
+		return 0, false
+	}
+	r, size := utf8.DecodeRuneInString(input[pos:])
+	if !n.matches(r) {
+		return 0, false
+	}
+	return size, true
+}
+
+// Matches its elements one after another.
+type sequenceNode struct {
+	elements []node
+}
+
+func (n *sequenceNode) match(m *machine, pos int, k continuation) bool {
+	return n.matchFrom(m, 0, pos, k)
+}
+
+func (n *sequenceNode) matchFrom(m *machine, i, pos int, k continuation) bool {
+	if i == len(n.elements) {
+		return k(pos)
+	}
+	return n.elements[i].match(m, pos, func(end int) bool {
+		return n.matchFrom(m, i+1, end, k)
+	})
+}
+
+// Tries its alternatives from left to right.
+type alternationNode struct {
+	alternatives []node
+}
+
+func (n *alternationNode) match(m *machine, pos int, k continuation) bool {
+	for _, alternative := range n.alternatives {
+		if alternative.match(m, pos, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Records the bounds of a capture group.
+type captureNode struct {
+	index int
+	regex node
+}
+
+func (n *captureNode) match(m *machine, pos int, k continuation) bool {
+	startSlot := 2 * n.index
+	endSlot := startSlot + 1
+	return n.regex.match(m, pos, func(end int) bool {
+		oldStart := m.captures[startSlot]
+		oldEnd := m.captures[endSlot]
+		m.captures[startSlot] = pos
+		m.captures[endSlot] = end
+		if k(end) {
+			return true
+		}
+		m.captures[startSlot] = oldStart
+		m.captures[endSlot] = oldEnd
+		return false
+	})
+}
+
+// Repeats a node between `min` and `max` times.
+// `max` is -1 when there is no upper bound.
+type repeatNode struct {
+	regex node
+	min   int
+	max   int
+	lazy  bool
+}
+
+func (n *repeatNode) match(m *machine, pos int, k continuation) bool {
+	if r, ok := n.regex.(*runeNode); ok {
+		if n.lazy {
+			return n.matchRunesLazy(m, r, pos, k)
+		}
+		return n.matchRunesGreedy(m, r, pos, k)
+	}
+
+	return n.matchCount(m, 0, pos, k)
+}
+
+func (n *repeatNode) matchCount(m *machine, count, pos int, k continuation) bool {
+	if !m.step() {
+		return false
+	}
+	if count < n.min {
+		return n.regex.match(m, pos, func(end int) bool {
+			return n.matchCount(m, count+1, end, k)
+		})
+	}
+	if n.max != -1 && count >= n.max {
+		return k(pos)
+	}
+
+	next := func(end int) bool {
+		// an empty iteration would repeat forever
+		if end == pos {
+			return false
+		}
+		return n.matchCount(m, count+1, end, k)
+	}
+	if n.lazy {
+		return k(pos) || n.regex.match(m, pos, next)
+	}
+	return n.regex.match(m, pos, next) || k(pos)
+}
+
+// Greedy repetition of a single char
+// implemented without recursion.
+func (n *repeatNode) matchRunesGreedy(m *machine, r *runeNode, pos int, k continuation) bool {
+	ends := []int{pos}
+	for n.max == -1 || len(ends)-1 < n.max {
+		if !m.step() {
+			return false
+		}
+		size, ok := r.matchRune(m.input, pos)
+		if !ok {
+			break
+		}
+		pos += size
+		ends = append(ends, pos)
+	}
+
+	for i := len(ends) - 1; i >= n.min; i-- {
+		if k(ends[i]) {
+			return true
+		}
+		if m.exceeded() {
+			return false
+		}
+	}
+	return false
+}
+
+// Lazy repetition of a single char
+// implemented without recursion.
+func (n *repeatNode) matchRunesLazy(m *machine, r *runeNode, pos int, k continuation) bool {
+	var count int
+	for {
+		if !m.step() {
+			return false
+		}
+		if count >= n.min {
+			if k(pos) {
+				return true
+			}
+			if n.max != -1 && count >= n.max {
+				return false
+			}
+		}
+		size, ok := r.matchRune(m.input, pos)
+		if !ok {
+			return false
+		}
+		pos += size
+		count++
+	}
+}
+
+// Matches a zero-width assertion.
+type assertionNode struct {
+	matches func(input string, pos int) bool
+}
+
+func (n *assertionNode) match(m *machine, pos int, k continuation) bool {
+	if !m.step() {
+		return false
+	}
+	if !n.matches(m.input, pos) {
+		return false
+	}
+	return k(pos)
+}
+
+// Matches the text captured by a group.
+// Fails when the group has not participated in the match.
+type backreferenceNode struct {
+	index int
+	fold  bool
+}
+
+func (n *backreferenceNode) match(m *machine, pos int, k continuation) bool {
+	if !m.step() {
+		return false
+	}
+	start := m.captures[2*n.index]
+	end := m.captures[2*n.index+1]
+	if start == -1 || end == -1 {
+		return false
+	}
+	captured := m.input[start:end]
+	if !n.fold {
+		if len(m.input)-pos < len(captured) || m.input[pos:pos+len(captured)] != captured {
+			return false
+		}
+		return k(pos + len(captured))
+	}
+
+	for _, c := range captured {
+		if pos >= len(m.input) {
+			return false
+		}
+		r, size := utf8.DecodeRuneInString(m.input[pos:])
+		if !foldEqual(c, r) {
+			return false
+		}
+		pos += size
+	}
+	return k(pos)
+}
+
+// Matches its content at most once
+// and never backtracks into it.
+type atomicNode struct {
+	regex node
+}
+
+func (n *atomicNode) match(m *machine, pos int, k continuation) bool {
+	saved := m.saveCaptures()
+	end := -1
+	if !n.regex.match(m, pos, func(e int) bool {
+		end = e
+		return true
+	}) {
+		return false
+	}
+	if k(end) {
+		return true
+	}
+	m.restoreCaptures(saved)
+	return false
+}
+
+// Checks whether its content matches
+// after (lookahead) or before (lookbehind) the current position
+// without consuming any text.
+type lookaroundNode struct {
+	regex   node
+	behind  bool
+	negated bool
+	// maximum number of chars matched by `regex`,
+	// -1 when unbounded
+	maxWidth int
+}
+
+func (n *lookaroundNode) match(m *machine, pos int, k continuation) bool {
+	if !m.step() {
+		return false
+	}
+	saved := m.saveCaptures()
+	var matched bool
+	if n.behind {
+		matched = n.matchBehind(m, pos)
+	} else {
+		matched = n.regex.match(m, pos, func(int) bool { return true })
+	}
+
+	if n.negated {
+		m.restoreCaptures(saved)
+		if matched || m.exceeded() {
+			return false
+		}
+		return k(pos)
+	}
+
+	if !matched {
+		return false
+	}
+	if k(pos) {
+		return true
+	}
+	m.restoreCaptures(saved)
+	return false
+}
+
+// Try to match the content so that it ends at `pos`.
+func (n *lookaroundNode) matchBehind(m *machine, pos int) bool {
+	start := pos
+	var width int
+	for {
+		if n.regex.match(m, start, func(end int) bool { return end == pos }) {
+			return true
+		}
+		if start == 0 || n.maxWidth != -1 && width >= n.maxWidth || m.exceeded() {
+			return false
+		}
+		_, size := utf8.DecodeLastRuneInString(m.input[:start])
+		start -= size
+		width++
+	}
+}
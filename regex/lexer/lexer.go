@@ -521,6 +521,9 @@ charLoop:
 			case 'V':
 				l.advanceChar()
 				return l.token(token.NOT_VERTICAL_WHITESPACE_CHAR_CLASS)
+			case 'k':
+				l.advanceChar()
+				return l.token(token.BACKREFERENCE)
 			case '.', '?', '-', '+', '*', '^', '\\', '|', '$', '(', ')', '[', ']', '{', '}', ' ':
 				char, _ := l.advanceChar()
 				return l.tokenWithValue(token.META_CHAR_ESCAPE, string(char))
@@ -49,9 +49,14 @@ func (*ZeroOrMoreQuantifierNode) concatenationElementNode() {}
 func (*OneOrMoreQuantifierNode) concatenationElementNode()  {}
 func (*NQuantifierNode) concatenationElementNode()          {}
 func (*NMQuantifierNode) concatenationElementNode()         {}
+func (*PossessiveQuantifierNode) concatenationElementNode() {}
 
 func (*MetaCharEscapeNode) concatenationElementNode()                   {}
 func (*GroupNode) concatenationElementNode()                            {}
+func (*AtomicGroupNode) concatenationElementNode()                      {}
+func (*LookaheadNode) concatenationElementNode()                        {}
+func (*LookbehindNode) concatenationElementNode()                       {}
+func (*BackreferenceNode) concatenationElementNode()                    {}
 func (*CharClassNode) concatenationElementNode()                        {}
 func (*QuotedTextNode) concatenationElementNode()                       {}
 func (*CharNode) concatenationElementNode()                             {}
@@ -93,6 +98,10 @@ type PrimaryRegexNode interface {
 func (*InvalidNode) primaryRegexNode()                          {}
 func (*MetaCharEscapeNode) primaryRegexNode()                   {}
 func (*GroupNode) primaryRegexNode()                            {}
+func (*AtomicGroupNode) primaryRegexNode()                      {}
+func (*LookaheadNode) primaryRegexNode()                        {}
+func (*LookbehindNode) primaryRegexNode()                       {}
+func (*BackreferenceNode) primaryRegexNode()                    {}
 func (*CharClassNode) primaryRegexNode()                        {}
 func (*QuotedTextNode) primaryRegexNode()                       {}
 func (*CharNode) primaryRegexNode()                             {}
@@ -258,6 +267,66 @@ func NewGroupNode(span *position.Span, regex Node, name string, setFlags, unsetF
 	}
 }
 
+// Represents an atomic group eg. `(?>foo|fo)`
+type AtomicGroupNode struct {
+	NodeBase
+	Regex Node
+}
+
+// Create a new atomic group node.
+func NewAtomicGroupNode(span *position.Span, regex Node) *AtomicGroupNode {
+	return &AtomicGroupNode{
+		NodeBase: NodeBase{span: span},
+		Regex:    regex,
+	}
+}
+
+// Represents a lookahead eg. `(?=foo)`, `(?!\d)`
+type LookaheadNode struct {
+	NodeBase
+	Regex   Node
+	Negated bool
+}
+
+// Create a new lookahead node.
+func NewLookaheadNode(span *position.Span, regex Node, negated bool) *LookaheadNode {
+	return &LookaheadNode{
+		NodeBase: NodeBase{span: span},
+		Regex:    regex,
+		Negated:  negated,
+	}
+}
+
+// Represents a lookbehind eg. `(?<=foo)`, `(?<!\d)`
+type LookbehindNode struct {
+	NodeBase
+	Regex   Node
+	Negated bool
+}
+
+// Create a new lookbehind node.
+func NewLookbehindNode(span *position.Span, regex Node, negated bool) *LookbehindNode {
+	return &LookbehindNode{
+		NodeBase: NodeBase{span: span},
+		Regex:    regex,
+		Negated:  negated,
+	}
+}
+
+// Represents a backreference to a capture group eg. `\1`, `\k<foo>`, `\k'2'`
+type BackreferenceNode struct {
+	NodeBase
+	Value string // name or number of the group
+}
+
+// Create a new backreference node.
+func NewBackreferenceNode(span *position.Span, value string) *BackreferenceNode {
+	return &BackreferenceNode{
+		NodeBase: NodeBase{span: span},
+		Value:    value,
+	}
+}
+
 // Represents union eg. `foo|bar`, `\w|\d`
 type UnionNode struct {
 	NodeBase
@@ -360,6 +429,21 @@ func NewNMQuantifierNode(span *position.Span, regex Node, n, m string, alt bool)
 	}
 }
 
+// Represents a possessive quantifier that never gives back
+// what it has matched eg. `f++`, `\w*+`, `f{2,}+`
+type PossessiveQuantifierNode struct {
+	NodeBase
+	Quantifier Node
+}
+
+// Create a new possessive quantifier node.
+func NewPossessiveQuantifierNode(span *position.Span, quantifier Node) *PossessiveQuantifierNode {
+	return &PossessiveQuantifierNode{
+		NodeBase:   NodeBase{span: span},
+		Quantifier: quantifier,
+	}
+}
+
 // Represents a meta-char escape eg. `\\`, `\.`, `\+`
 type MetaCharEscapeNode struct {
 	NodeBase
@@ -4,6 +4,7 @@ package parser
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -32,6 +33,7 @@ type Parser struct {
 	nextLookahead *token.Token // second next token used for predicting productions
 	errors        diagnostic.DiagnosticList
 	mode          mode
+	captureGroups int // number of capture groups opened so far
 }
 
 // Instantiate a new parser.
@@ -61,6 +63,7 @@ func (p *Parser) reset() {
 	p.lexer = lexer.New(p.source)
 	p.mode = normalMode
 	p.errors = nil
+	p.captureGroups = 0
 }
 
 // Same as [errorExpected] but lets you pass a token type.
@@ -332,8 +335,41 @@ func (p *Parser) consumeFlags(stopTokens ...token.Type) (setFlags bitfield.BitFi
 	return setFlags, unsetFlags, span
 }
 
-// quantifier = primaryRegex ["+" | "+?" | "*" | "*?" | "?" | "??" | "{" DIGIT+ ["," DIGIT*] "}"]
+// quantifier = basicQuantifier ["+"]
 func (p *Parser) quantifier() ast.ConcatenationElementNode {
+	q := p.basicQuantifier()
+	if !isGreedyQuantifier(q) || !p.accept(token.PLUS) {
+		return q
+	}
+
+	plus := p.advance()
+	return ast.NewPossessiveQuantifierNode(
+		q.Span().Join(plus.Span()),
+		q,
+	)
+}
+
+// Checks whether the node is a greedy quantifier
+// that can be made possessive.
+func isGreedyQuantifier(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.ZeroOrOneQuantifierNode:
+		return !n.Alt
+	case *ast.ZeroOrMoreQuantifierNode:
+		return !n.Alt
+	case *ast.OneOrMoreQuantifierNode:
+		return !n.Alt
+	case *ast.NQuantifierNode:
+		return !n.Alt
+	case *ast.NMQuantifierNode:
+		return !n.Alt
+	default:
+		return false
+	}
+}
+
+// basicQuantifier = primaryRegex ["+" | "+?" | "*" | "*?" | "?" | "??" | "{" DIGIT+ ["," DIGIT*] "}"]
+func (p *Parser) basicQuantifier() ast.ConcatenationElementNode {
 	r := p.primaryRegex()
 	switch p.lookahead.Type {
 	case token.PLUS:
@@ -520,7 +556,13 @@ func (p *Parser) primaryRegex() ast.PrimaryRegexNode {
 	case token.OCTAL_ESCAPE:
 		return p.octalEscape()
 	case token.SIMPLE_OCTAL_ESCAPE:
+		if p.isBackreference(p.lookahead.Value) {
+			tok := p.advance()
+			return ast.NewBackreferenceNode(tok.Span(), tok.Value)
+		}
 		return p.simpleOctalEscape()
+	case token.BACKREFERENCE:
+		return p.backreference()
 	case token.UNICODE_CHAR_CLASS:
 		return p.unicodeCharClass()
 	case token.NEGATED_UNICODE_CHAR_CLASS:
@@ -558,6 +600,52 @@ func (p *Parser) carriageReturnEscape() *ast.CarriageReturnEscapeNode {
 	return ast.NewCarriageReturnEscapeNode(tok.Span())
 }
 
+// Checks whether the digits of a simple octal escape like `\1`
+// refer to a capture group opened before the escape.
+func (p *Parser) isBackreference(digits string) bool {
+	if len(digits) == 0 || digits[0] == '0' {
+		return false
+	}
+
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return false
+	}
+	return n <= p.captureGroups
+}
+
+// backreference = "\k" ("<" groupReference ">" | "'" groupReference "'" | "{" groupReference "}")
+func (p *Parser) backreference() ast.PrimaryRegexNode {
+	begTok := p.advance()
+	var endType token.Type
+	switch p.lookahead.Type {
+	case token.LANGLE:
+		endType = token.RANGLE
+	case token.SINGLE_QUOTE:
+		endType = token.SINGLE_QUOTE
+	case token.LBRACE:
+		endType = token.RBRACE
+	default:
+		p.errorExpected("a group reference")
+		return ast.NewInvalidNode(begTok.Span(), begTok)
+	}
+	p.advance()
+
+	var name string
+	if p.accept(token.CHAR) && charIsDigit(p.lookahead.Char()) {
+		name, _ = p.consumeDigits(endType, token.RPAREN)
+	} else {
+		name, _ = p.consumeLetters(endType, token.RPAREN)
+	}
+	endTok, _ := p.consume(endType)
+	span := begTok.Span().Join(endTok.Span())
+	if len(name) == 0 {
+		p.errorMessageSpan("expected a group name or number", span)
+	}
+
+	return ast.NewBackreferenceNode(span, name)
+}
+
 func (p *Parser) simpleOctalEscape() *ast.OctalEscapeNode {
 	tok := p.advance()
 	return ast.NewOctalEscapeNode(tok.Span(), tok.Value)
@@ -747,7 +835,7 @@ func (p *Parser) primaryCharClassElement() ast.CharClassElementNode {
 	return ast.NewInvalidNode(t.Span(), t)
 }
 
-// group = "(" ["?" (":" | (["P"] "<" ALPHA_CHAR* ">")] union ")"
+// group = "(" ["?" (":" | (["P"] "<" ALPHA_CHAR* ">")] union ")" | lookaround | atomicGroup
 func (p *Parser) group() ast.PrimaryRegexNode {
 	lparen := p.advance()
 	var nonCapturing, onlyFlags bool
@@ -755,11 +843,20 @@ func (p *Parser) group() ast.PrimaryRegexNode {
 	var setFlags, unsetFlags bitfield.BitField8
 	var lastSpan *position.Span
 	var content ast.Node
+	capturing := true
 
 	if _, ok := p.matchOk(token.QUESTION); ok {
+		capturing = false
 		if _, ok := p.matchOk(token.COLON); ok {
 			nonCapturing = true
+		} else if p.acceptLookaroundChar() {
+			return p.lookaround(lparen, false)
+		} else if p.match(token.RANGLE) {
+			return p.atomicGroup(lparen)
 		} else if p.match(token.LANGLE) {
+			if p.acceptLookaroundChar() {
+				return p.lookaround(lparen, true)
+			}
 			name, _ = p.consumeLetters(token.RANGLE, token.RPAREN)
 			rangle, _ := p.consume(token.RANGLE)
 			if len(name) == 0 {
@@ -786,6 +883,9 @@ func (p *Parser) group() ast.PrimaryRegexNode {
 			}
 		}
 	}
+	if capturing || len(name) > 0 {
+		p.captureGroups++
+	}
 	if !onlyFlags {
 		content = p.union(token.RPAREN)
 	}
@@ -805,6 +905,43 @@ func (p *Parser) group() ast.PrimaryRegexNode {
 	)
 }
 
+// Checks whether the next token is `=` or `!`
+// that begins a lookaround.
+func (p *Parser) acceptLookaroundChar() bool {
+	return p.lookahead.Type == token.CHAR &&
+		(p.lookahead.Value == "=" || p.lookahead.Value == "!")
+}
+
+// lookaround = "(?" ["<"] ("=" | "!") union ")"
+func (p *Parser) lookaround(lparen *token.Token, behind bool) ast.PrimaryRegexNode {
+	negated := p.advance().Value == "!"
+	content := p.union(token.RPAREN)
+	rparen, ok := p.consume(token.RPAREN)
+	if !ok {
+		return ast.NewInvalidNode(rparen.Span(), rparen)
+	}
+
+	span := lparen.Span().Join(rparen.Span())
+	if behind {
+		return ast.NewLookbehindNode(span, content, negated)
+	}
+	return ast.NewLookaheadNode(span, content, negated)
+}
+
+// atomicGroup = "(?>" union ")"
+func (p *Parser) atomicGroup(lparen *token.Token) ast.PrimaryRegexNode {
+	content := p.union(token.RPAREN)
+	rparen, ok := p.consume(token.RPAREN)
+	if !ok {
+		return ast.NewInvalidNode(rparen.Span(), rparen)
+	}
+
+	return ast.NewAtomicGroupNode(
+		lparen.Span().Join(rparen.Span()),
+		content,
+	)
+}
+
 const hexLiteralChars = "0123456789abcdefABCDEF"
 
 func charIsHex(char rune) bool {
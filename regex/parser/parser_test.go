@@ -2289,3 +2289,248 @@ func TestGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestLookaround(t *testing.T) {
+	tests := testTable{
+		"lookahead": {
+			input: `(?=a)`,
+			want: ast.NewLookaheadNode(
+				S(P(0, 1, 1), P(4, 1, 5)),
+				ast.NewCharNode(
+					S(P(3, 1, 4), P(3, 1, 4)),
+					'a',
+				),
+				false,
+			),
+		},
+		"negative lookahead": {
+			input: `(?!a)`,
+			want: ast.NewLookaheadNode(
+				S(P(0, 1, 1), P(4, 1, 5)),
+				ast.NewCharNode(
+					S(P(3, 1, 4), P(3, 1, 4)),
+					'a',
+				),
+				true,
+			),
+		},
+		"lookbehind": {
+			input: `(?<=a)`,
+			want: ast.NewLookbehindNode(
+				S(P(0, 1, 1), P(5, 1, 6)),
+				ast.NewCharNode(
+					S(P(4, 1, 5), P(4, 1, 5)),
+					'a',
+				),
+				false,
+			),
+		},
+		"negative lookbehind": {
+			input: `(?<!a)`,
+			want: ast.NewLookbehindNode(
+				S(P(0, 1, 1), P(5, 1, 6)),
+				ast.NewCharNode(
+					S(P(4, 1, 5), P(4, 1, 5)),
+					'a',
+				),
+				true,
+			),
+		},
+		"atomic group": {
+			input: `(?>ab)`,
+			want: ast.NewAtomicGroupNode(
+				S(P(0, 1, 1), P(5, 1, 6)),
+				ast.NewConcatenationNode(
+					S(P(3, 1, 4), P(4, 1, 5)),
+					[]ast.ConcatenationElementNode{
+						ast.NewCharNode(
+							S(P(3, 1, 4), P(3, 1, 4)),
+							'a',
+						),
+						ast.NewCharNode(
+							S(P(4, 1, 5), P(4, 1, 5)),
+							'b',
+						),
+					},
+				),
+			),
+		},
+		"missing right paren": {
+			input: `(?=a`,
+			want: ast.NewInvalidNode(
+				S(P(4, 1, 5), P(3, 1, 4)),
+				T(S(P(4, 1, 5), P(3, 1, 4)), token.END_OF_FILE),
+			),
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(4, 1, 5), P(3, 1, 4)), "unexpected END_OF_FILE, expected )"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			parserTest(tc, t)
+		})
+	}
+}
+
+func TestPossessiveQuantifier(t *testing.T) {
+	tests := testTable{
+		"zero or more": {
+			input: `a*+`,
+			want: ast.NewPossessiveQuantifierNode(
+				S(P(0, 1, 1), P(2, 1, 3)),
+				ast.NewZeroOrMoreQuantifierNode(
+					S(P(0, 1, 1), P(1, 1, 2)),
+					ast.NewCharNode(
+						S(P(0, 1, 1), P(0, 1, 1)),
+						'a',
+					),
+					false,
+				),
+			),
+		},
+		"one or more": {
+			input: `a++`,
+			want: ast.NewPossessiveQuantifierNode(
+				S(P(0, 1, 1), P(2, 1, 3)),
+				ast.NewOneOrMoreQuantifierNode(
+					S(P(0, 1, 1), P(1, 1, 2)),
+					ast.NewCharNode(
+						S(P(0, 1, 1), P(0, 1, 1)),
+						'a',
+					),
+					false,
+				),
+			),
+		},
+		"n quantifier": {
+			input: `a{2}+`,
+			want: ast.NewPossessiveQuantifierNode(
+				S(P(0, 1, 1), P(4, 1, 5)),
+				ast.NewNQuantifierNode(
+					S(P(0, 1, 1), P(3, 1, 4)),
+					ast.NewCharNode(
+						S(P(0, 1, 1), P(0, 1, 1)),
+						'a',
+					),
+					"2",
+					false,
+				),
+			),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			parserTest(tc, t)
+		})
+	}
+}
+
+func TestBackreference(t *testing.T) {
+	tests := testTable{
+		"numeric after group": {
+			input: `(a)\1`,
+			want: ast.NewConcatenationNode(
+				S(P(0, 1, 1), P(4, 1, 5)),
+				[]ast.ConcatenationElementNode{
+					ast.NewGroupNode(
+						S(P(0, 1, 1), P(2, 1, 3)),
+						ast.NewCharNode(
+							S(P(1, 1, 2), P(1, 1, 2)),
+							'a',
+						),
+						"",
+						bitfield.BitField8{},
+						bitfield.BitField8{},
+						false,
+					),
+					ast.NewBackreferenceNode(
+						S(P(3, 1, 4), P(4, 1, 5)),
+						"1",
+					),
+				},
+			),
+		},
+		"numeric after non capturing group is octal": {
+			input: `(?:a)\1`,
+			want: ast.NewConcatenationNode(
+				S(P(0, 1, 1), P(6, 1, 7)),
+				[]ast.ConcatenationElementNode{
+					ast.NewGroupNode(
+						S(P(0, 1, 1), P(4, 1, 5)),
+						ast.NewCharNode(
+							S(P(3, 1, 4), P(3, 1, 4)),
+							'a',
+						),
+						"",
+						bitfield.BitField8{},
+						bitfield.BitField8{},
+						true,
+					),
+					ast.NewOctalEscapeNode(
+						S(P(5, 1, 6), P(6, 1, 7)),
+						"1",
+					),
+				},
+			),
+		},
+		"named with angle brackets": {
+			input: `\k<foo>`,
+			want: ast.NewBackreferenceNode(
+				S(P(0, 1, 1), P(6, 1, 7)),
+				"foo",
+			),
+		},
+		"named with apostrophes": {
+			input: `\k'foo'`,
+			want: ast.NewBackreferenceNode(
+				S(P(0, 1, 1), P(6, 1, 7)),
+				"foo",
+			),
+		},
+		"numeric with braces": {
+			input: `\k{12}`,
+			want: ast.NewBackreferenceNode(
+				S(P(0, 1, 1), P(5, 1, 6)),
+				"12",
+			),
+		},
+		"empty": {
+			input: `\k<>`,
+			want: ast.NewBackreferenceNode(
+				S(P(0, 1, 1), P(3, 1, 4)),
+				"",
+			),
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(0, 1, 1), P(3, 1, 4)), "expected a group name or number"),
+			},
+		},
+		"missing group reference": {
+			input: `\ka`,
+			want: ast.NewConcatenationNode(
+				S(P(0, 1, 1), P(2, 1, 3)),
+				[]ast.ConcatenationElementNode{
+					ast.NewInvalidNode(
+						S(P(0, 1, 1), P(1, 1, 2)),
+						T(S(P(0, 1, 1), P(1, 1, 2)), token.BACKREFERENCE),
+					),
+					ast.NewCharNode(
+						S(P(2, 1, 3), P(2, 1, 3)),
+						'a',
+					),
+				},
+			),
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(2, 1, 3), P(2, 1, 3)), "unexpected CHAR, expected a group reference"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			parserTest(tc, t)
+		})
+	}
+}
@@ -65,6 +65,7 @@ const (
 	NOT_HORIZONTAL_WHITESPACE_CHAR_CLASS             // Not horizontal whitespace char class `\H`
 	VERTICAL_WHITESPACE_CHAR_CLASS                   // Vertical whitespace char class `\v`
 	NOT_VERTICAL_WHITESPACE_CHAR_CLASS               // Not vertical whitespace char class `\V`
+	BACKREFERENCE                                    // Backreference `\k`
 )
 
 var tokenNames = [...]string{
@@ -119,4 +120,5 @@ var tokenNames = [...]string{
 	NOT_HORIZONTAL_WHITESPACE_CHAR_CLASS: `\H`,
 	VERTICAL_WHITESPACE_CHAR_CLASS:       `\v`,
 	NOT_VERTICAL_WHITESPACE_CHAR_CLASS:   `\V`,
+	BACKREFERENCE:                        `\k`,
 }
@@ -20,8 +20,13 @@ func Transpile(elkRegex string, flags bitfield.BitField8) (string, diagnostic.Di
 		return "", err
 	}
 
+	return TranspileNode(ast, flags)
+}
+
+// Transpile a parsed Elk regex to Go regex syntax.
+func TranspileNode(node ast.Node, flags bitfield.BitField8) (string, diagnostic.DiagnosticList) {
 	t := &transpiler{Flags: flags}
-	t.transpileNode(ast)
+	t.transpileNode(node)
 	if t.Errors != nil {
 		return "", t.Errors
 	}
@@ -50,6 +55,11 @@ func (t *transpiler) newLocation(span *position.Span) *position.Location {
 	return position.NewLocation("regex", span)
 }
 
+// Report a feature that cannot be expressed in Go regex syntax.
+func (t *transpiler) unsupported(message string, node ast.Node) {
+	t.Errors.AddFailure(message, t.newLocation(node.Span()))
+}
+
 func asciiLetterIndex(char rune) int {
 	if char >= 'A' && char <= 'Z' {
 		return int(char - 'A' + 1)
@@ -167,6 +177,16 @@ func (t *transpiler) transpileNode(node ast.Node) {
 		t.verticalWhitespaceCharClass()
 	case *ast.NotVerticalWhitespaceCharClassNode:
 		t.notVerticalWhitespaceCharClass(n)
+	case *ast.LookaheadNode:
+		t.unsupported("lookahead is not supported", n)
+	case *ast.LookbehindNode:
+		t.unsupported("lookbehind is not supported", n)
+	case *ast.AtomicGroupNode:
+		t.unsupported("atomic groups are not supported", n)
+	case *ast.PossessiveQuantifierNode:
+		t.unsupported("possessive quantifiers are not supported", n)
+	case *ast.BackreferenceNode:
+		t.unsupported("backreferences are not supported", n)
 	case *ast.AnyCharClassNode:
 		t.anyCharClass()
 	case nil:
@@ -1337,3 +1337,44 @@ func TestExtendedFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestBacktrackingFeatures(t *testing.T) {
+	tests := testTable{
+		"lookahead": {
+			input: `a(?=b)`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(1, 1, 2), P(5, 1, 6)), "lookahead is not supported"),
+			},
+		},
+		"lookbehind": {
+			input: `(?<!b)a`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(0, 1, 1), P(5, 1, 6)), "lookbehind is not supported"),
+			},
+		},
+		"atomic group": {
+			input: `(?>a)`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(0, 1, 1), P(4, 1, 5)), "atomic groups are not supported"),
+			},
+		},
+		"possessive quantifier": {
+			input: `a?+`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(0, 1, 1), P(2, 1, 3)), "possessive quantifiers are not supported"),
+			},
+		},
+		"backreference": {
+			input: `(a)\1`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("regex", P(3, 1, 4), P(4, 1, 5)), "backreferences are not supported"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			transpilerTest(tc, t)
+		})
+	}
+}
@@ -62,7 +62,7 @@ func CheckAST(sourceName string, ast *ast.ProgramNode, globalEnv *types.GlobalEn
 	if cmp == nil {
 		return nil, checker.Errors.DiagnosticList
 	}
-	return cmp.Bytecode(), checker.Errors.DiagnosticList
+	return checker.attachGlobalEnv(cmp.Bytecode()), checker.Errors.DiagnosticList
 }
 
 // Check the types of an Elk AST and generate Go source
@@ -83,7 +83,7 @@ func CheckFile(fileName string, globalEnv *types.GlobalEnvironment, flags bitfie
 	if cmp == nil {
 		return nil, checker.Errors.DiagnosticList
 	}
-	return cmp.Bytecode(), checker.Errors.DiagnosticList
+	return checker.attachGlobalEnv(cmp.Bytecode()), checker.Errors.DiagnosticList
 }
 
 // Check the types of an Elk file and generate Go source
@@ -424,7 +424,7 @@ func (c *Checker) CheckSourceBytecode(sourceName string, source string) (*vm.Byt
 		return nil, err
 	}
 
-	return cmp.Bytecode(), err
+	return c.attachGlobalEnv(cmp.Bytecode()), err
 }
 
 // Used in the REPL to typecheck and compile the input to Go source code
@@ -1844,6 +1844,10 @@ func (c *Checker) StdAnyInt() types.Type {
 	return c.runtimeEnv.StdSubtype(symbol.AnyInt)
 }
 
+func (c *Checker) StdAnyFloat() types.Type {
+	return c.runtimeEnv.StdSubtype(symbol.AnyFloat)
+}
+
 func (c *Checker) StdBool() *types.Class {
 	return c.runtimeEnv.StdSubtypeClass(symbol.Bool)
 }
@@ -6029,7 +6033,7 @@ func (c *Checker) checkGoExpressionNode(node *ast.GoExpressionNode) ast.Expressi
 	c.setHasDefer(false)
 
 	c.addImpureErrorIfInPureContext(node.Location())
-	c.pushNestedLocalEnv(defaultLocalEnvType)
+	c.pushNestedLocalEnv(goLocalEnvType)
 	c.checkStatements(node.Body, false)
 	c.popLocalEnv()
 
@@ -6291,7 +6295,7 @@ func (c *Checker) addValueReassignedError(name string, location *position.Locati
 }
 
 func (c *Checker) checkLocalVariableAssignment(name string, node *ast.AssignmentExpressionNode) ast.ExpressionNode {
-	variable, _ := c.resolveLocal(name, node.Left.Location())
+	variable, localCtx := c.resolveLocal(name, node.Left.Location())
 	if variable == nil {
 		node.Left.SetType(types.Untyped{})
 		c.checkExpression(node.Right)
@@ -6299,6 +6303,16 @@ func (c *Checker) checkLocalVariableAssignment(name string, node *ast.Assignment
 		return node
 	}
 
+	if localCtx.nestedInGo {
+		c.addWarning(
+			fmt.Sprintf(
+				"assigning to local `%s` captured by `go` is not thread safe, use an atomic value like `Std::Sync::AtomicInt` or a `Std::Sync::Mutex`",
+				name,
+			),
+			node.Left.Location(),
+		)
+	}
+
 	if variable.singleAssignment && variable.initialised {
 		c.addValueReassignedError(name, node.Left.Location())
 	}
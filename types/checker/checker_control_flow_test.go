@@ -22,6 +22,21 @@ func TestGoExpression(t *testing.T) {
 				diagnostic.NewFailure(L("<main>", P(58, 7, 5), P(58, 7, 5)), "undefined local `b`"),
 			},
 		},
+		"warns about assigning to captured locals": {
+			input: `
+				a := 5
+				go
+					a = 10
+					a++
+					b := 1
+					b = 2
+				end
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewWarning(L("<main>", P(24, 4, 6), P(24, 4, 6)), "assigning to local `a` captured by `go` is not thread safe, use an atomic value like `Std::Sync::AtomicInt` or a `Std::Sync::Mutex`"),
+				diagnostic.NewWarning(L("<main>", P(36, 5, 6), P(36, 5, 6)), "assigning to local `a` captured by `go` is not thread safe, use an atomic value like `Std::Sync::AtomicInt` or a `Std::Sync::Mutex`"),
+			},
+		},
 		"returns a Thread": {
 			input: `
 				var a: nil = go println("foo")
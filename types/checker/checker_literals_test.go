@@ -1393,6 +1393,33 @@ func TestRegexLiteral(t *testing.T) {
 				diagnostic.NewFailure(L("<main>", P(38, 3, 19), P(42, 3, 23)), "type `Foo` does not implement interface `Std::String::Convertible`:\n\n  - missing method `Std::String::Convertible.:to_string` with signature: `def to_string(): Std::String`"),
 			},
 		},
+		"replace matches with a template in a method": {
+			input: `
+				def fix(s: String): String
+					%/(\w)(\d)/.replace_all(s, '$2$1')
+				end
+				def fix_first(s: String): String
+					%/(\w)(\d)/.replace(s, '$2$1')
+				end
+			`,
+		},
+		"replace matches with a function in a method": {
+			input: `
+				def fix(s: String): String
+					%/\d/.replace_all(s, |m: Regex::Match|: String -> m.to_string * 2)
+				end
+			`,
+		},
+		"replace matches with a throwing function without catching": {
+			input: `
+				def fix(s: String): String
+					%/\d/.replace_all(s, |m: Regex::Match|: String ! String -> throw m.to_string)
+				end
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("<main>", P(43, 3, 12), P(53, 3, 22)), "thrown value of type `Std::String` must be caught or added to the signature of the function `! void | Std::String`"),
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -4967,3 +4967,93 @@ func TestClosureLiteral(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatStrings(t *testing.T) {
+	tests := testTable{
+		"valid format call": {
+			input: `
+				var a: String = String.format("%-10s %08.3f %x %p %c %%", "foo", 1.5, 10, nil, ` + "`a`" + `)
+			`,
+		},
+		"valid format operator": {
+			input: `
+				var a: String = "%s: %d" % ["foo", 1]
+				var b: String = "%05.1f" % 1
+			`,
+		},
+		"format string that is not a literal": {
+			input: `
+				f := "%d"
+				String.format(f, "foo")
+			`,
+		},
+		"tuple that is not a literal": {
+			input: `
+				args := %[1, 2]
+				"%d" % args
+			`,
+		},
+		"splat arguments": {
+			input: `
+				args := [1, 2]
+				String.format("%d %d %d", *args)
+			`,
+		},
+		"invalid specifier": {
+			input: `
+				String.format("foo %5q", 1)
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("<main>", P(24, 2, 24), P(26, 2, 26)), "invalid format specifier `%5q`"),
+			},
+		},
+		"missing argument": {
+			input: `
+				"%s and %s" % "foo"
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("<main>", P(13, 2, 13), P(14, 2, 14)), "missing argument for format specifier `%s`"),
+			},
+		},
+		"too many arguments": {
+			input: `
+				String.format("%s", "foo", 1, 2)
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("<main>", P(32, 2, 32), P(32, 2, 32)), "too many arguments for format string, expected 1, got 3"),
+			},
+		},
+		"wrong argument type": {
+			input: `
+				String.format("%d|%-8.2f|%c", 1.5, "foo", 1)
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("<main>", P(20, 2, 20), P(21, 2, 21)), "format specifier `%d` expects type `Std::AnyInt`, got type `1.5`"),
+				diagnostic.NewFailure(L("<main>", P(23, 2, 23), P(28, 2, 28)), "format specifier `%-8.2f` expects type `Std::AnyFloat | Std::AnyInt`, got type `\"foo\"`"),
+				diagnostic.NewFailure(L("<main>", P(30, 2, 30), P(31, 2, 31)), "format specifier `%c` expects type `Std::Char`, got type `1`"),
+			},
+		},
+		"literal with multibyte chars": {
+			input: `
+				'żółw %d' % "foo"
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("<main>", P(14, 2, 11), P(15, 2, 12)), "format specifier `%d` expects type `Std::AnyInt`, got type `\"foo\"`"),
+			},
+		},
+		"literal with escapes": {
+			input: `
+				"\t%d" % "foo"
+			`,
+			err: diagnostic.DiagnosticList{
+				diagnostic.NewFailure(L("<main>", P(5, 2, 5), P(10, 2, 10)), "format specifier `%d` expects type `Std::AnyInt`, got type `\"foo\"`"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			checkerTest(tc, t)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package checker
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/compiler"
+	"github.com/elk-language/elk/parser"
+	"github.com/elk-language/elk/position/diagnostic"
+	"github.com/elk-language/elk/types"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
+	"github.com/elk-language/elk/vm"
+)
+
+// Type information of a compiled program
+// shared by all of its bytecode functions.
+// Code evaluated at runtime is typechecked against it
+// and its new definitions are added to it.
+type programEnv struct {
+	m   sync.Mutex
+	env *types.GlobalEnvironment
+}
+
+// Attach the global environment of the checker to a compiled program.
+// Threads that execute the program use it
+// to typecheck source code evaluated at runtime.
+func (c *Checker) attachGlobalEnv(fn *vm.BytecodeFunction) *vm.BytecodeFunction {
+	attachProgramEnv(fn, &programEnv{env: c.runtimeEnv})
+	return fn
+}
+
+// Attach the environment to the function and all functions nested in it.
+func attachProgramEnv(fn *vm.BytecodeFunction, env *programEnv) {
+	if fn.GlobalEnv == env {
+		return
+	}
+	fn.GlobalEnv = env
+
+	for _, val := range fn.Values {
+		if nested, ok := val.SafeAsReference().(*vm.BytecodeFunction); ok {
+			attachProgramEnv(nested, env)
+		}
+	}
+}
+
+// Returns the environment of the program executed by the given thread.
+// Returns a new environment when the program has not been typechecked.
+func currentProgramEnv(thread *vm.Thread) *programEnv {
+	env, ok := thread.CurrentGlobalEnv().(*programEnv)
+	if !ok {
+		return &programEnv{env: NewGlobalEnvironment()}
+	}
+
+	return env
+}
+
+// Returns a copy of the global environment of the program
+// executed by the given thread.
+// Returns a new global environment when the program has not been typechecked.
+func RuntimeGlobalEnvironment(thread *vm.Thread) *types.GlobalEnvironment {
+	env := currentProgramEnv(thread)
+	env.m.Lock()
+	defer env.m.Unlock()
+
+	return env.env.DeepCopyEnv()
+}
+
+// Options of the evaluation of source code at runtime.
+type EvalOptions struct {
+	SourceName string
+	Bindings   []EvalBinding
+	NewThread  bool           // whether the code should be executed in a new thread instead of the current one
+	Aborter    *value.Aborter // optional aborter that can terminate the evaluated code
+}
+
+// A local variable available in code evaluated at runtime.
+type EvalBinding struct {
+	Name  value.Symbol
+	Value value.Value
+}
+
+// Create a new checker that typechecks
+// source code evaluated at runtime against the given global environment.
+func NewEvalChecker(sourceName string, env *types.GlobalEnvironment) *Checker {
+	c := newChecker(sourceName, env, bitfield.BitField16FromBitFlag(AdditionalAbortChecks), nil, vm.DefaultThreadPool, false)
+	return c
+}
+
+// Typecheck and compile source code evaluated at runtime.
+// The given names are defined as untyped local variables,
+// they are the parameters of the returned bytecode function.
+func (c *Checker) CheckEvalSource(source string, locals []value.Symbol) (*vm.BytecodeFunction, diagnostic.DiagnosticList) {
+	ast, err := parser.Parse(c.Filename, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(locals) > 0 {
+		localCompiler := compiler.CreateBytecodeCompiler(nil, c, ast.Location(), c.Errors, c.HasAdditionalAbortChecks())
+		for _, name := range locals {
+			localName := name.String()
+			localCompiler.DefineLocals(localName)
+			c.addLocal(localName, newLocal(types.Untyped{}, true, false))
+		}
+		c.compiler = localCompiler
+	}
+
+	cmp := c.CheckProgram(ast)
+	if cmp == nil {
+		return nil, c.Errors.DiagnosticList
+	}
+
+	fn := cmp.Bytecode()
+	fn.SetParameterCount(len(locals))
+	return fn, c.Errors.DiagnosticList
+}
+
+// Typecheck source code against the global environment
+// of the program executed by the given thread without executing it.
+func CheckRuntimeSource(thread *vm.Thread, sourceName string, source string) diagnostic.DiagnosticList {
+	c := NewEvalChecker(sourceName, RuntimeGlobalEnvironment(thread))
+	_, diagnostics := c.CheckEvalSource(source, nil)
+	return diagnostics
+}
+
+// Typecheck, compile and execute source code at runtime.
+// Returns a `Std::Elk::Type::Checker::Error` with diagnostics when the code does not compile.
+//
+// Definitions made by the evaluated code become visible
+// to code evaluated later by the same program.
+// When `opts.NewThread` is true the code gets executed in a new thread
+// and a `Std::Thread` is returned immediately.
+func Eval(thread *vm.Thread, source string, opts *EvalOptions) (value.Value, value.Value) {
+	names := make([]value.Symbol, len(opts.Bindings))
+	args := make([]value.Value, len(opts.Bindings)+1)
+	args[0] = value.GlobalObject.ToValue()
+	for i, binding := range opts.Bindings {
+		names[i] = binding.Name
+		args[i+1] = binding.Value
+	}
+
+	fn, err := checkEvalSource(thread, source, names, opts)
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+
+	if opts.NewThread {
+		aborter := opts.Aborter
+		closure := vm.NewNativeClosure(
+			func(thread *vm.Thread, _ []value.Value) (value.Value, value.Value) {
+				if aborter != nil {
+					thread.Aborter = aborter
+				}
+				return thread.CallMethod(fn, args...)
+			},
+			0,
+			fn.Location,
+		)
+		return value.Ref(thread.GoNative(closure)), value.Undefined
+	}
+
+	if opts.Aborter != nil {
+		prevAborter := thread.Aborter
+		thread.Aborter = opts.Aborter
+		defer func() {
+			thread.Aborter = prevAborter
+		}()
+	}
+	return thread.CallMethod(fn, args...)
+}
+
+// Typecheck and compile source code evaluated at runtime
+// against the environment of the program executed by the thread.
+// Evaluations in the same program are checked one at a time
+// so that their definitions do not get lost.
+func checkEvalSource(thread *vm.Thread, source string, names []value.Symbol, opts *EvalOptions) (*vm.BytecodeFunction, value.Value) {
+	env := currentProgramEnv(thread)
+	env.m.Lock()
+	defer env.m.Unlock()
+
+	c := NewEvalChecker(opts.SourceName, env.env.DeepCopyEnv())
+	fn, diagnostics := c.CheckEvalSource(source, names)
+	if diagnostics.IsFailure() {
+		return nil, newEvalCheckerError(diagnostics)
+	}
+
+	env.env = c.runtimeEnv
+	attachProgramEnv(fn, env)
+	return fn, value.Undefined
+}
+
+// Create a `Std::Elk::Type::Checker::Error` with the given diagnostics.
+func newEvalCheckerError(diagnostics diagnostic.DiagnosticList) value.Value {
+	message := "evaluated code does not compile"
+	for _, diag := range diagnostics {
+		if diag.Severity == diagnostic.FAIL {
+			message = fmt.Sprintf("%s: %s", message, diag.Message)
+			break
+		}
+	}
+
+	return value.NewObject(
+		value.ObjectWithClass(value.ElkTypeCheckerErrorClass),
+		value.ObjectWithInstanceVariablesByName(value.SymbolMap{
+			symbol.L_message:     value.String(message).ToValue(),
+			symbol.L_diagnostics: (*value.DiagnosticList)(&diagnostics).ToValue(),
+		}),
+	).ToValue()
+}
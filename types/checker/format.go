@@ -0,0 +1,178 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/elk-language/elk/parser/ast"
+	"github.com/elk-language/elk/position"
+	"github.com/elk-language/elk/types"
+	"github.com/elk-language/elk/value/formatscanner"
+	"github.com/elk-language/elk/value/symbol"
+)
+
+// Verify the arguments of calls to `Std::String.format` and `Std::String#%`
+// when the format string is a literal.
+func (c *Checker) checkFormatCall(method *types.Method, receiver ast.ExpressionNode, args []ast.ExpressionNode) {
+	var formatNode ast.ExpressionNode
+	var formatArgs []ast.ExpressionNode
+
+	switch {
+	case method.Name == symbol.OpModulo && method.DefinedUnder == c.StdString():
+		if len(args) != 1 {
+			return
+		}
+		formatNode = receiver
+		switch arg := args[0].(type) {
+		case *ast.ArrayTupleLiteralNode:
+			formatArgs = arg.Elements
+		case *ast.ArrayListLiteralNode:
+			if arg.Capacity != nil {
+				return
+			}
+			formatArgs = arg.Elements
+		default:
+			if c.IsSubtype(c.TypeOf(arg), c.Std(symbol.Tuple)) {
+				return
+			}
+			formatArgs = args
+		}
+	case method.Name == symbol.L_format && method.DefinedUnder == c.StdString().Singleton():
+		if len(args) != 2 {
+			return
+		}
+		formatNode = args[0]
+		rest, ok := args[1].(*ast.ArrayTupleLiteralNode)
+		if !ok {
+			return
+		}
+		formatArgs = rest.Elements
+	default:
+		return
+	}
+
+	var format string
+	switch f := formatNode.(type) {
+	case *ast.RawStringLiteralNode:
+		format = f.Value
+	case *ast.DoubleQuotedStringLiteralNode:
+		format = f.Value
+	default:
+		return
+	}
+
+	for _, arg := range formatArgs {
+		switch arg.(type) {
+		case *ast.SplatExpressionNode, *ast.ModifierNode,
+			*ast.ModifierIfElseNode, *ast.ModifierForInNode:
+			// the number of arguments is not known
+			return
+		}
+	}
+
+	c.checkFormatString(format, formatNode.Location(), formatArgs)
+}
+
+// Verify the specifiers of a literal format string
+// against the given arguments.
+func (c *Checker) checkFormatString(format string, location *position.Location, args []ast.ExpressionNode) {
+	scanner := formatscanner.New(format)
+	var argIndex int
+
+tokenLoop:
+	for {
+		token, spec := scanner.Next()
+		switch token {
+		case formatscanner.END_OF_FILE:
+			break tokenLoop
+		case formatscanner.INVALID_SPECIFIER:
+			c.addFailure(
+				fmt.Sprintf("invalid format specifier `%s`", spec.Value),
+				formatSpecifierLocation(format, location, spec),
+			)
+			return
+		case formatscanner.SPECIFIER:
+			if argIndex >= len(args) {
+				c.addFailure(
+					fmt.Sprintf("missing argument for format specifier `%s`", spec.Value),
+					formatSpecifierLocation(format, location, spec),
+				)
+				return
+			}
+
+			arg := args[argIndex]
+			argIndex++
+			expectedType := c.formatSpecifierType(spec.Verb)
+			argType := c.TypeOf(arg)
+			if c.IsSubtype(argType, expectedType) {
+				continue
+			}
+			c.addFailure(
+				fmt.Sprintf(
+					"format specifier `%s` expects type `%s`, got type `%s`",
+					spec.Value,
+					types.InspectWithColor(expectedType),
+					types.InspectWithColor(argType),
+				),
+				formatSpecifierLocation(format, location, spec),
+			)
+		}
+	}
+
+	if argIndex < len(args) {
+		c.addFailure(
+			fmt.Sprintf(
+				"too many arguments for format string, expected %d, got %d",
+				argIndex,
+				len(args),
+			),
+			args[argIndex].Location(),
+		)
+	}
+}
+
+// Returns the type of values accepted by a format verb.
+func (c *Checker) formatSpecifierType(verb rune) types.Type {
+	switch verb {
+	case 's':
+		return c.StdStringConvertible()
+	case 'p':
+		return c.StdInspectable()
+	case 'd', 'i', 'x', 'X', 'o', 'b':
+		return c.StdAnyInt()
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		return c.NewNormalisedUnion(c.StdAnyFloat(), c.StdAnyInt())
+	case 'c':
+		return c.Std(symbol.Char)
+	default:
+		panic(fmt.Sprintf("invalid format verb: %c", verb))
+	}
+}
+
+// Returns the location of a specifier in a string literal.
+// Returns the location of the entire literal
+// when the specifier cannot be mapped to the source,
+// because the literal spans multiple lines or contains escapes.
+func formatSpecifierLocation(format string, location *position.Location, spec formatscanner.Specifier) *position.Location {
+	startPos := location.StartPos
+	endPos := location.EndPos
+	// the literal is surrounded by quotes
+	contentLength := endPos.ByteOffset - startPos.ByteOffset - 1
+	if contentLength != len(format) || startPos.Line != endPos.Line || strings.ContainsRune(format, '\n') {
+		return location
+	}
+
+	specStartColumn := startPos.Column + 1 + utf8.RuneCountInString(format[:spec.Start])
+	specStart := position.New(
+		startPos.ByteOffset+1+spec.Start,
+		startPos.Line,
+		specStartColumn,
+	)
+	specEnd := position.New(
+		startPos.ByteOffset+spec.Start+len(spec.Value),
+		startPos.Line,
+		specStartColumn+utf8.RuneCountInString(spec.Value)-1,
+	)
+	return position.NewLocation(location.FilePath, position.NewSpan(specStart, specEnd))
+}
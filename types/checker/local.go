@@ -134,6 +134,7 @@ func newLocal(typ types.Type, initialised, singleAssignment bool) *local {
 type localContext struct {
 	env                      *localEnvironment
 	nestedInConditionalScope bool
+	nestedInGo               bool // whether the local has been captured by a `go` body
 }
 
 type localEnvType byte
@@ -142,6 +143,7 @@ const (
 	defaultLocalEnvType localEnvType = iota
 	macroBoundaryLocalEnvType
 	conditionalLocalEnvType
+	goLocalEnvType
 )
 
 // Contains definitions of local variables and values
@@ -205,6 +207,7 @@ func (l *localEnvironment) resolveLocal(name string, unhygienic bool) (*local, *
 	currentEnv := l
 
 	var nestedInConditionalScope bool
+	var nestedInGo bool
 
 	for {
 		if currentEnv == nil {
@@ -216,6 +219,7 @@ func (l *localEnvironment) resolveLocal(name string, unhygienic bool) (*local, *
 			return loc, &localContext{
 				env:                      currentEnv,
 				nestedInConditionalScope: nestedInConditionalScope,
+				nestedInGo:               nestedInGo,
 			}
 		}
 		switch currentEnv.typ {
@@ -225,6 +229,8 @@ func (l *localEnvironment) resolveLocal(name string, unhygienic bool) (*local, *
 			}
 		case conditionalLocalEnvType:
 			nestedInConditionalScope = true
+		case goLocalEnvType:
+			nestedInGo = true
 		}
 
 		currentEnv = currentEnv.parent
@@ -1570,6 +1570,26 @@ func (c *Checker) checkMethodArgumentsAndInferTypeArguments(
 			return ast.DeepCopy(arg).(ast.NamedArgumentNode)
 		})
 
+		if typeParams == nil && overload.IsGeneric() {
+			// generic overload of a non-generic method
+			overload = c.deepCopyMethod(overload)
+			posArgs, typeArgs := c._checkMethodArgumentsAndInferTypeArguments(
+				overload,
+				posArgs,
+				namedArgs,
+				overload.TypeParameters,
+				location,
+			)
+			if tempDiagnostics.IsFailure() || len(typeArgs) != len(overload.TypeParameters) {
+				continue
+			}
+
+			c.Errors = prevDiagnostics
+			overload.ReturnType = c.replaceTypeParameters(overload.ReturnType, typeArgs, true)
+			overload.ThrowType = c.replaceTypeParameters(overload.ThrowType, typeArgs, true)
+			return overload, posArgs, nil
+		}
+
 		posArgs, typeArgs := c._checkMethodArgumentsAndInferTypeArguments(
 			overload,
 			posArgs,
@@ -2208,6 +2228,7 @@ func (c *Checker) checkSimpleMethodCall(
 	if method == nil {
 		return methodName, receiver, positionalArgumentNodes, types.Untyped{}
 	}
+	c.checkFormatCall(method, receiver, typedPositionalArguments)
 
 	var returnType types.Type
 	switch op {
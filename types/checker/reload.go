@@ -0,0 +1,336 @@
+package checker
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/position"
+	"github.com/elk-language/elk/position/diagnostic"
+	"github.com/elk-language/elk/types"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
+)
+
+// Reloads methods of a running program
+// when its source files change.
+//
+// The whole program gets type checked again,
+// but only methods defined in modified files
+// are swapped into the live method tables.
+// Changes to the layout of instance variables,
+// class hierarchies and new namespaces are reported
+// as diagnostics and are not applied.
+// Constants and top level expressions are never re-executed.
+type Reloader struct {
+	FileName string // name of the entry file of the program
+	flags    bitfield.BitField16
+	env      *types.GlobalEnvironment // global environment of the running program
+	modTimes map[string]time.Time     // modification times of the source files of the program
+}
+
+// Name of the bytecode function that defines
+// all methods of a compiled program.
+var methodDefinitionsSymbol = value.ToSymbol("<methodDefinitions>")
+
+// Create a new reloader for the program defined in the given file.
+// Enables hot reloading in the VM,
+// so it should be created before the program starts running.
+func NewReloader(fileName string, flags bitfield.BitField16) *Reloader {
+	vm.EnableHotReload()
+	return &Reloader{
+		FileName: fileName,
+		flags:    flags,
+	}
+}
+
+// Check the types of the program and generate bytecode.
+// The returned bytecode is the program that can be reloaded.
+func (r *Reloader) Check() (*vm.BytecodeFunction, diagnostic.DiagnosticList) {
+	c, fn := r.check()
+	if fn == nil {
+		return nil, c.Errors.DiagnosticList
+	}
+
+	r.env = c.runtimeEnv
+	r.modTimes = sourceModTimes(c)
+	return c.attachGlobalEnv(fn), c.Errors.DiagnosticList
+}
+
+func (r *Reloader) check() (*Checker, *vm.BytecodeFunction) {
+	c := newChecker(r.FileName, nil, r.flags, nil, vm.DefaultThreadPool, false)
+	cmp := c.checkFile(r.FileName)
+	if cmp == nil {
+		return c, nil
+	}
+	return c, cmp.Bytecode()
+}
+
+// Returns the names of the source files of the program.
+func (r *Reloader) Files() []string {
+	return slices.Sorted(maps.Keys(r.modTimes))
+}
+
+// Reports whether any source file of the program
+// has been modified since the last successful reload.
+func (r *Reloader) Changed() bool {
+	return len(r.changedFiles()) > 0
+}
+
+// Returns the set of source files that have been
+// modified or removed since the last reload.
+func (r *Reloader) changedFiles() map[string]bool {
+	changed := make(map[string]bool)
+	for fileName, modTime := range r.modTimes {
+		info, err := os.Stat(fileName)
+		if err != nil || !info.ModTime().Equal(modTime) {
+			changed[fileName] = true
+		}
+	}
+
+	return changed
+}
+
+// Check the program again and swap the methods
+// defined in modified files into the live method tables of the VM.
+// Returns diagnostics and a flag indicating whether
+// the new code has been applied.
+//
+// It is safe to call while the program is running.
+func (r *Reloader) Reload() (diagnostic.DiagnosticList, bool) {
+	changedFiles := r.changedFiles()
+	c, fn := r.check()
+	modTimes := sourceModTimes(c)
+	if fn == nil {
+		r.modTimes = modTimes
+		return c.Errors.DiagnosticList, false
+	}
+
+	incompatible := r.checkCompatibility(c.runtimeEnv)
+	if len(incompatible) > 0 {
+		r.modTimes = modTimes
+		return slices.Concat(c.Errors.DiagnosticList, incompatible), false
+	}
+
+	methodDefinitions := findBytecodeFunction(fn, methodDefinitionsSymbol)
+	if methodDefinitions != nil {
+		err := vm.ReloadMethods(methodDefinitions, func(method *vm.BytecodeFunction) bool {
+			if method.Location == nil {
+				return true
+			}
+			_, known := r.modTimes[method.Location.FilePath]
+			return !known || changedFiles[method.Location.FilePath]
+		})
+		if !err.IsUndefined() {
+			r.modTimes = modTimes
+			return append(
+				c.Errors.DiagnosticList,
+				diagnostic.NewFailure(
+					position.NewLocation(r.FileName, position.DefaultSpan),
+					fmt.Sprintf("could not define reloaded methods: %s", err.Inspect()),
+				),
+			), false
+		}
+	}
+
+	r.env = c.runtimeEnv
+	r.modTimes = modTimes
+	return c.Errors.DiagnosticList, true
+}
+
+// Poll the source files of the program in the given interval
+// and reload it when they change.
+// The callback is executed after every reload.
+// Returns when the `done` channel gets closed.
+func (r *Reloader) Watch(interval time.Duration, done <-chan struct{}, callback func(diagnostics diagnostic.DiagnosticList, applied bool)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if !r.Changed() {
+				continue
+			}
+			diagnostics, applied := r.Reload()
+			if callback != nil {
+				callback(diagnostics, applied)
+			}
+		}
+	}
+}
+
+// Compare the namespaces of the running program
+// with the namespaces of the new version of the program
+// and report changes that cannot be applied at runtime.
+func (r *Reloader) checkCompatibility(newEnv *types.GlobalEnvironment) diagnostic.DiagnosticList {
+	var result diagnostic.DiagnosticList
+	location := position.NewLocation(r.FileName, position.DefaultSpan)
+	report := func(format string, args ...any) {
+		result = append(result, diagnostic.NewFailure(location, fmt.Sprintf(format, args...)))
+	}
+
+	oldNamespaces := collectNamespaces(r.env)
+	newNamespaces := collectNamespaces(newEnv)
+
+	for _, name := range slices.Sorted(maps.Keys(newNamespaces)) {
+		newNamespace := newNamespaces[name]
+		oldNamespace, ok := oldNamespaces[name]
+		if !ok {
+			report("cannot define new namespace `%s` while reloading", name)
+			continue
+		}
+		if oldNamespace.IsNative() {
+			continue
+		}
+
+		oldKind := namespaceKind(oldNamespace)
+		newKind := namespaceKind(newNamespace)
+		if oldKind != newKind {
+			report("cannot change `%s` from %s to %s while reloading", name, oldKind, newKind)
+			continue
+		}
+
+		oldParents := namespaceParentNames(oldNamespace)
+		newParents := namespaceParentNames(newNamespace)
+		if !slices.Equal(oldParents, newParents) {
+			report(
+				"cannot change the hierarchy of `%s` while reloading, was: `%s`, is: `%s`",
+				name,
+				strings.Join(oldParents, " < "),
+				strings.Join(newParents, " < "),
+			)
+			continue
+		}
+
+		oldIvars := namespaceIvarNames(oldNamespace)
+		newIvars := namespaceIvarNames(newNamespace)
+		if !slices.Equal(oldIvars, newIvars) || !ivarIndicesEqual(oldNamespace, newNamespace) {
+			report(
+				"cannot change the instance variables of `%s` while reloading, was: `%s`, is: `%s`",
+				name,
+				strings.Join(oldIvars, ", "),
+				strings.Join(newIvars, ", "),
+			)
+			continue
+		}
+	}
+
+	return result
+}
+
+// Returns a map of all namespaces defined in the given environment
+// indexed by their full names.
+func collectNamespaces(env *types.GlobalEnvironment) map[string]types.Namespace {
+	namespaces := make(map[string]types.Namespace)
+
+	var collect func(namespace types.Namespace)
+	collect = func(namespace types.Namespace) {
+		name := namespace.Name()
+		if _, ok := namespaces[name]; ok {
+			return
+		}
+		namespaces[name] = namespace
+
+		for _, subtype := range namespace.Subtypes() {
+			switch t := subtype.Type.(type) {
+			case *types.Class, *types.Module, *types.Mixin, *types.Interface:
+				collect(t.(types.Namespace))
+			}
+		}
+	}
+	collect(env.Root)
+
+	return namespaces
+}
+
+func namespaceKind(namespace types.Namespace) string {
+	switch namespace.(type) {
+	case *types.Class:
+		return "class"
+	case *types.Module:
+		return "module"
+	case *types.Mixin:
+		return "mixin"
+	case *types.Interface:
+		return "interface"
+	default:
+		return "namespace"
+	}
+}
+
+// Returns the names of the direct parents (superclasses and mixins) of the namespace.
+func namespaceParentNames(namespace types.Namespace) []string {
+	var names []string
+	for parent := range types.DirectParents(namespace) {
+		if parent == namespace {
+			continue
+		}
+		names = append(names, parent.Name())
+	}
+	return names
+}
+
+// Returns the sorted names of all instance variables of the namespace.
+func namespaceIvarNames(namespace types.Namespace) []string {
+	var names []string
+	for ivar := range types.SortedInstanceVariables(namespace) {
+		names = append(names, ivar.Name.String())
+	}
+	return names
+}
+
+func ivarIndicesEqual(a, b types.Namespace) bool {
+	aIndices, ok := a.(types.NamespaceWithIvarIndices)
+	if !ok {
+		return true
+	}
+	bIndices, ok := b.(types.NamespaceWithIvarIndices)
+	if !ok {
+		return true
+	}
+	if aIndices.IvarIndices() == nil || bIndices.IvarIndices() == nil {
+		return aIndices.IvarIndices() == bIndices.IvarIndices()
+	}
+
+	return maps.Equal(*aIndices.IvarIndices(), *bIndices.IvarIndices())
+}
+
+// Find a bytecode function with the given name in the values of another function.
+func findBytecodeFunction(fn *vm.BytecodeFunction, name value.Symbol) *vm.BytecodeFunction {
+	for _, val := range fn.Values {
+		subFn, ok := val.SafeAsReference().(*vm.BytecodeFunction)
+		if !ok {
+			continue
+		}
+		if subFn.Name() == name {
+			return subFn
+		}
+	}
+
+	return nil
+}
+
+// Returns the modification times of the source files checked by the checker.
+func sourceModTimes(c *Checker) map[string]time.Time {
+	modTimes := make(map[string]time.Time)
+	for fileName := range c.ASTCache.Map {
+		if filepath.Ext(fileName) != ".elk" {
+			continue
+		}
+		info, err := os.Stat(fileName)
+		if err != nil {
+			continue
+		}
+		modTimes[fileName] = info.ModTime()
+	}
+
+	return modTimes
+}
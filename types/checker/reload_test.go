@@ -0,0 +1,229 @@
+package checker_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/types/checker"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
+	"github.com/k0kubun/pp/v3"
+)
+
+func writeReloadTestFile(t *testing.T, fileName, source string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(fileName, []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(fileName, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newReloadTestProgram(t *testing.T, className, source string) (*checker.Reloader, string, value.Value) {
+	t.Helper()
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "main.elk")
+	writeReloadTestFile(t, fileName, source, time.Now().Add(-time.Hour))
+
+	reloader := checker.NewReloader(fileName, bitfield.BitField16{})
+	bytecode, diagnostics := reloader.Check()
+	if diagnostics.IsFailure() {
+		t.Fatalf("unexpected diagnostics: %s", pp.Sprint(diagnostics))
+	}
+	_, err := vm.New().InterpretTopLevel(bytecode)
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+
+	class := value.RootModule.Constants.GetString(className).AsReference().(*value.Class)
+	self := value.Ref(value.NewObject(value.ObjectWithClass(class)))
+	return reloader, fileName, self
+}
+
+func callReloadTestMethod(t *testing.T, name string, cc **vm.CallCache, self value.Value) string {
+	t.Helper()
+	result, err := vm.New().CallMethodByNameWithCache(value.ToSymbol(name), cc, self)
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+	return string(result.AsReference().(value.String))
+}
+
+func TestReloadMethods(t *testing.T) {
+	source := `
+		class ReloadTestGreeter
+			def greet: String then "hello"
+			def call_greet: String then greet()
+		end
+	`
+	reloader, fileName, self := newReloadTestProgram(t, "ReloadTestGreeter", source)
+	cc := &vm.CallCache{}
+
+	if got := callReloadTestMethod(t, "greet", &cc, self); got != "hello" {
+		t.Fatalf("got %q, expected %q", got, "hello")
+	}
+	if reloader.Changed() {
+		t.Fatal("the program should not be marked as changed")
+	}
+
+	writeReloadTestFile(t, fileName, strings.ReplaceAll(source, `"hello"`, `"goodbye"`), time.Now())
+	if !reloader.Changed() {
+		t.Fatal("the program should be marked as changed")
+	}
+
+	diagnostics, applied := reloader.Reload()
+	if diagnostics.IsFailure() || !applied {
+		t.Fatalf("reload should be applied, diagnostics: %s", pp.Sprint(diagnostics))
+	}
+	if reloader.Changed() {
+		t.Fatal("the program should not be marked as changed after reloading")
+	}
+
+	if got := callReloadTestMethod(t, "greet", &cc, self); got != "goodbye" {
+		t.Fatalf("got %q, expected %q", got, "goodbye")
+	}
+	if got := callReloadTestMethod(t, "call_greet", &cc, self); got != "goodbye" {
+		t.Fatalf("got %q, expected %q", got, "goodbye")
+	}
+}
+
+func TestReloadIncompatibleChanges(t *testing.T) {
+	tests := map[string]struct {
+		className string
+		source    string
+		changed   string
+		err       string
+	}{
+		"change ivars": {
+			className: "ReloadTestIvars",
+			source: `
+				class ReloadTestIvars
+					var @a: Int?
+					def foo: String then "old"
+				end
+			`,
+			changed: `
+				class ReloadTestIvars
+					var @a: Int?
+					var @b: Int?
+					def foo: String then "new"
+				end
+			`,
+			err: "cannot change the instance variables of `ReloadTestIvars` while reloading, was: `a`, is: `a, b`",
+		},
+		"change superclass": {
+			className: "ReloadTestHierarchy",
+			source: `
+				class ReloadTestHierarchyParent; end
+				class ReloadTestHierarchy
+					def foo: String then "old"
+				end
+			`,
+			changed: `
+				class ReloadTestHierarchyParent; end
+				class ReloadTestHierarchy < ReloadTestHierarchyParent
+					def foo: String then "new"
+				end
+			`,
+			err: "cannot change the hierarchy of `ReloadTestHierarchy` while reloading, was: `Std::Object < Std::Value`, is: `ReloadTestHierarchyParent < Std::Object < Std::Value`",
+		},
+		"define a new namespace": {
+			className: "ReloadTestNew",
+			source: `
+				class ReloadTestNew
+					def foo: String then "old"
+				end
+			`,
+			changed: `
+				class ReloadTestNew
+					def foo: String then "new"
+				end
+				module ReloadTestNewModule; end
+			`,
+			err: "cannot define new namespace `ReloadTestNewModule` while reloading",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reloader, fileName, self := newReloadTestProgram(t, tc.className, tc.source)
+			cc := &vm.CallCache{}
+
+			writeReloadTestFile(t, fileName, tc.changed, time.Now())
+			diagnostics, applied := reloader.Reload()
+			if applied {
+				t.Fatal("reload should not be applied")
+			}
+			if len(diagnostics) != 1 || diagnostics[0].Message != tc.err {
+				t.Fatalf("got diagnostics: %s, expected: %s", pp.Sprint(diagnostics), tc.err)
+			}
+
+			if got := callReloadTestMethod(t, "foo", &cc, self); got != "old" {
+				t.Fatalf("got %q, expected %q", got, "old")
+			}
+		})
+	}
+}
+
+func TestReloadOnlyChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	mainFileName := filepath.Join(dir, "main.elk")
+	otherFileName := filepath.Join(dir, "other.elk")
+	mainSource := `
+		import "./other.elk"
+
+		class ReloadTestMain
+			def greet: String then ReloadTestOther.label
+		end
+	`
+	otherSource := `
+		module ReloadTestOther
+			def label: String then "old"
+		end
+	`
+	modTime := time.Now().Add(-time.Hour)
+	writeReloadTestFile(t, mainFileName, mainSource, modTime)
+	writeReloadTestFile(t, otherFileName, otherSource, modTime)
+
+	reloader := checker.NewReloader(mainFileName, bitfield.BitField16{})
+	bytecode, diagnostics := reloader.Check()
+	if diagnostics.IsFailure() {
+		t.Fatalf("unexpected diagnostics: %s", pp.Sprint(diagnostics))
+	}
+	_, err := vm.New().InterpretTopLevel(bytecode)
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+
+	class := value.RootModule.Constants.GetString("ReloadTestMain").AsReference().(*value.Class)
+	self := value.Ref(value.NewObject(value.ObjectWithClass(class)))
+	other := value.RootModule.Constants.GetString("ReloadTestOther").AsReference().(*value.Module)
+	nameMethod := other.SingletonClass().LookupMethod(value.ToSymbol("label"))
+	cc := &vm.CallCache{}
+
+	writeReloadTestFile(t, mainFileName, strings.ReplaceAll(mainSource, "ReloadTestOther.label", `"new " + ReloadTestOther.label`), time.Now())
+	diagnostics, applied := reloader.Reload()
+	if diagnostics.IsFailure() || !applied {
+		t.Fatalf("reload should be applied, diagnostics: %s", pp.Sprint(diagnostics))
+	}
+	if got := callReloadTestMethod(t, "greet", &cc, self); got != "new old" {
+		t.Fatalf("got %q, expected %q", got, "new old")
+	}
+	if other.SingletonClass().LookupMethod(value.ToSymbol("label")) != nameMethod {
+		t.Fatal("methods from unchanged files should not be replaced")
+	}
+
+	writeReloadTestFile(t, otherFileName, strings.ReplaceAll(otherSource, `"old"`, `"newer"`), time.Now())
+	diagnostics, applied = reloader.Reload()
+	if diagnostics.IsFailure() || !applied {
+		t.Fatalf("reload should be applied, diagnostics: %s", pp.Sprint(diagnostics))
+	}
+	if got := callReloadTestMethod(t, "greet", &cc, self); got != "new newer" {
+		t.Fatalf("got %q, expected %q", got, "new newer")
+	}
+}
@@ -0,0 +1,31 @@
+package runtime
+
+import (
+	"github.com/elk-language/elk/types/checker"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
+)
+
+// Std::Elk::Type::Checker
+func initChecker() {
+	// Singleton methods
+	c := &value.ElkTypeCheckerClass.SingletonClass().MethodContainer
+	vm.Def(
+		c,
+		"check",
+		func(thread *vm.Thread, args []value.Value) (value.Value, value.Value) {
+			source := string(args[1].AsReference().(value.String))
+
+			var sourceName string
+			if args[2].IsUndefined() {
+				sourceName = "<check>"
+			} else {
+				sourceName = string(args[2].AsReference().(value.String))
+			}
+
+			diagnostics := checker.CheckRuntimeSource(thread, sourceName, source)
+			return value.Ref((*value.DiagnosticList)(&diagnostics)), value.Undefined
+		},
+		vm.DefWithParameters(2),
+	)
+}
@@ -0,0 +1,50 @@
+package runtime
+
+import (
+	"github.com/elk-language/elk/types/checker"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
+)
+
+// Std::Elk
+func initElk() {
+	// Singleton methods
+	c := &value.ElkModule.SingletonClass().MethodContainer
+	vm.Def(
+		c,
+		"eval",
+		func(thread *vm.Thread, args []value.Value) (value.Value, value.Value) {
+			source := string(args[1].AsReference().(value.String))
+			opts := &checker.EvalOptions{
+				SourceName: "<eval>",
+			}
+
+			bindingsVal := args[2]
+			if !bindingsVal.IsUndefined() && !bindingsVal.IsNil() {
+				for pairVal, err := range vm.Iterate(thread, bindingsVal) {
+					if !err.IsUndefined() {
+						return value.Undefined, err
+					}
+					pair := pairVal.AsReference().(value.Pair)
+					opts.Bindings = append(opts.Bindings, checker.EvalBinding{
+						Name:  pair.Key().AsInlineSymbol(),
+						Value: pair.Value(),
+					})
+				}
+			}
+
+			if !args[3].IsUndefined() {
+				opts.SourceName = string(args[3].AsReference().(value.String))
+			}
+			if !args[4].IsUndefined() {
+				opts.NewThread = value.Truthy(args[4])
+			}
+			if !args[5].IsUndefined() && !args[5].IsNil() {
+				opts.Aborter = (*value.Aborter)(args[5].Pointer())
+			}
+
+			return checker.Eval(thread, source, opts)
+		},
+		vm.DefWithParameters(5),
+	)
+}
@@ -1,6 +1,8 @@
 package runtime
 
 func InitGlobalEnvironment() {
+	initElk()
+	initChecker()
 	initError()
 }
 
@@ -18,10 +18,17 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 	{
 		namespace := namespace.TryDefineModule("", value.ToSymbol("Std"), env)
 		{
-			namespace := namespace.TryDefineClass("An `Aborter` is an object that can be used to send signal that execution should be terminated.\nIts useful in multithreading and timeouts.\n\n## Instantiation\n\nYou can create a default aborter that can be closed on demand using the constructor.\n\n```\n# closable aborter\naborter := Aborter()\n```\n\nThere are timeout aborters.\nYou can specify the amount of time after which the aborter will get automatically closed.\n\n```\n# aborter will be automatically closed after 2 minutes\naborter := Aborter.timeout(2.minutes)\n```\n\nAnother type of aborter is the deadline aborter.\nYou can specify the datetime at which the aborter will get automatically closed.\n\n```\ndeadline := DateTime.new(2026, 2, 16, 15, 30)\n\n# aborter will be automatically closed at the given datetime\naborter := Aborter.deadline(deadline)\n```\n\n## Closing\n\nYou can send a signal that execution should be terminated using the `close` method.\n\n```\naborter := Aborter()\naborter.close\n```\n\nNot every aborter can be closed.\nEvery aborter you create using the constructor methods\nwill be closable.\nIf you try to close an unclosable aborter an error will be thrown.\nYou can check if an aborter is closable by calling the `is_closable` method.\n\n```\naborter := Aborter()\naborter.is_closable #=> true\n```\n\n## Checking for closure\n\nYou can listen for a close event of an aborter by using the readonly channel\nreturned by the `closed` method.\n\n```\naborter := Aborter()\n<<aborter.closed # blocks until the aborter is closed\n```", false, true, true, false, false, value.ToSymbol("Aborter"), objectClass, env)
+			namespace := namespace.TryDefineClass("An `Aborter` is an object that can be used to send signal that execution should be terminated.\nIts useful in multithreading and timeouts.\n\n## Instantiation\n\nYou can create a default aborter that can be closed on demand using the constructor.\n\n```\n# closable aborter\naborter := Aborter()\n```\n\nThere are timeout aborters.\nYou can specify the amount of time after which the aborter will get automatically closed.\n\n```\n# aborter will be automatically closed after 2 minutes\naborter := Aborter.timeout(2.minutes)\n```\n\nAnother type of aborter is the deadline aborter.\nYou can specify the datetime at which the aborter will get automatically closed.\n\n```\ndeadline := DateTime.new(2026, 2, 16, 15, 30)\n\n# aborter will be automatically closed at the given datetime\naborter := Aborter.deadline(deadline)\n```\n\nSignal aborters get closed when the process receives one of the given signals.\nThey make it easy to shut down gracefully.\n\n```\n# aborter will be automatically closed on SIGINT or SIGTERM\naborter := Aborter.on_signal([:int, :term])\n```\n\n## Closing\n\nYou can send a signal that execution should be terminated using the `close` method.\n\n```\naborter := Aborter()\naborter.close\n```\n\nNot every aborter can be closed.\nEvery aborter you create using the constructor methods\nwill be closable.\nIf you try to close an unclosable aborter an error will be thrown.\nYou can check if an aborter is closable by calling the `is_closable` method.\n\n```\naborter := Aborter()\naborter.is_closable #=> true\n```\n\n## Checking for closure\n\nYou can listen for a close event of an aborter by using the readonly channel\nreturned by the `closed` method.\n\n```\naborter := Aborter()\n<<aborter.closed # blocks until the aborter is closed\n```", false, true, true, false, false, value.ToSymbol("Aborter"), objectClass, env)
 			namespace.TryDefineClass("Thrown when trying to close an unclosable aborter.", false, false, false, false, false, value.ToSymbol("CannotBeClosedError"), objectClass, env)
 			namespace.Name() // noop - avoid unused variable error
 		}
+		{
+			namespace := namespace.TryDefineClass("An actor processes messages from its mailbox one at a time\nin the workers of a thread pool (`ThreadPool::DEFAULT` by default).\nIt occupies a worker only when there are messages to process,\nso many actors can share a small pool.\n\nMessages can be sent with `tell` (fire and forget)\nor with `ask`, which returns a promise of the reply.\n\nExample:\n\n\tcounter := Actor.spawn(\n\t\tstate: -> Box(0),\n\t\treceive: |count, n: Int| -> count.set(count.get + n),\n\t)\n\tcounter << 2\n\tcounter.ask(3).await_sync #=> 5\n\nAn actor without a supervisor stops when it throws an error,\nmessages left in its mailbox get rejected with `Actor::StoppedError`.\n\nFunctions passed to an actor cannot capture local variables\nthat are still in use by the current thread,\notherwise they fail with `OpenClosureError`.", false, true, true, false, false, value.ToSymbol("Actor"), objectClass, env)
+			namespace.TryDefineClass("Thrown when an actor sends a message to itself\nwhile its mailbox is full,\nsince waiting for a free slot would block it forever.", false, false, false, false, false, value.ToSymbol("MailboxFullError"), objectClass, env)
+			namespace.TryDefineClass("Thrown when sending a message to an actor that has been stopped.", false, false, false, false, false, value.ToSymbol("StoppedError"), objectClass, env)
+			namespace.TryDefineClass("A supervisor restarts actors that fail while processing a message.\n\nRestarting an actor recreates its state with the `state` function\npassed to `Actor.spawn`, the messages in its mailbox are kept.\n\nThe strategy decides which actors get restarted:\n- `:one_for_one` restarts only the failed actor\n- `:one_for_all` restarts all actors of the supervisor\n\nWhen actors fail more than `max_restarts` times\nwithin the `within` time span, the supervisor gives up\nand stops all of its actors with the last error.\n\nExample:\n\n\tsupervisor := Actor::Supervisor(strategy: :one_for_all, max_restarts: 5)\n\tworker := Actor(|job: Job| -> job.run, supervisor: supervisor)", false, true, true, false, false, value.ToSymbol("Supervisor"), objectClass, env)
+			namespace.Name() // noop - avoid unused variable error
+		}
 		namespace.DefineSubtype(value.ToSymbol("AnyFloat"), NewNamedType("Std::AnyFloat", NewUnion(NameToType("Std::Float", env), NameToType("Std::Float64", env), NameToType("Std::Float32", env), NameToType("Std::BigFloat", env))))
 		namespace.DefineSubtype(value.ToSymbol("AnyInt"), NewNamedType("Std::AnyInt", NewUnion(NameToType("Std::Int", env), NameToType("Std::Int64", env), NameToType("Std::Int32", env), NameToType("Std::Int16", env), NameToType("Std::Int8", env), NameToType("Std::UInt64", env), NameToType("Std::UInt32", env), NameToType("Std::UInt16", env), NameToType("Std::UInt8", env), NameToType("Std::UInt", env))))
 		{
@@ -488,13 +495,22 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 		}
 		namespace.TryDefineClass("A base class for most errors in Elk stdlib.", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
 		{
-			namespace := namespace.TryDefineModule("Contains types used for interacting\n with the file system.", value.ToSymbol("FS"), env)
+			namespace := namespace.TryDefineModule("Contains types used for interacting\n with the file system.\n\n Failed operations throw subclasses of `Std::FileSystemError`.\n\n ```\n FS.write(\"greeting.txt\", \"hello\\n\")\n FS.append(\"greeting.txt\", \"world\\n\")\n FS.read(\"greeting.txt\") #=> \"hello\\nworld\\n\"\n ```", value.ToSymbol("FS"), env)
+			namespace.TryDefineClass("Thrown when operating on a closed file.", false, false, false, false, false, value.ToSymbol("ClosedError"), objectClass, env)
+			namespace.TryDefineClass("An entry of a directory tree yielded by `FS::Walker`.", false, true, true, true, false, value.ToSymbol("DirEntry"), objectClass, env)
+			namespace.TryDefineClass("Thrown when a file or directory already exists.", false, false, false, false, false, value.ToSymbol("ExistsError"), objectClass, env)
+			namespace.TryDefineClass("An open file with buffered reads and writes.\n\nThe `mode` determines how the file is opened:\n- `:read` - for reading (default)\n- `:write` - for writing, the file gets created or truncated\n- `:append` - for writing at the end, the file gets created when it does not exist\n- `:read_write` - for reading and writing, the file gets created when it does not exist\n\nWrites are buffered so the file should be closed\nor flushed when you are done writing.\n\nIterating over a file yields its lines.\n\n```\nfile := FS::File(\"notes.txt\")\nfor line in file\n  println line\nend\nfile.close\n```", false, true, true, false, false, value.ToSymbol("File"), objectClass, env)
+			namespace.TryDefineClass("Describes a file or directory.\nReturned by `FS.stat` and `FS.list_dir`.", false, true, true, true, false, value.ToSymbol("FileInfo"), objectClass, env)
+			namespace.TryDefineClass("A lazy iterator over the paths that match a glob pattern\nreturned by `FS.glob`.", false, true, true, true, false, value.ToSymbol("GlobIterator"), objectClass, env)
 			namespace.TryDefineClass("Represents the position of a piece of text in a file.\n\nIt is made up of a path and a span.", false, true, true, false, false, value.ToSymbol("Location"), objectClass, env)
+			namespace.TryDefineClass("Thrown when a file or directory does not exist.", false, false, false, false, false, value.ToSymbol("NotFoundError"), objectClass, env)
 			{
 				namespace := namespace.TryDefineClass("Represents a file system path.\nPaths are immutable and safe to use by multiple threads.", false, true, true, false, false, value.ToSymbol("Path"), objectClass, env)
 				namespace.TryDefineClass("", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
 				namespace.Name() // noop - avoid unused variable error
 			}
+			namespace.TryDefineClass("Thrown when the permissions do not allow the operation.", false, false, false, false, false, value.ToSymbol("PermissionError"), objectClass, env)
+			namespace.TryDefineClass("A lazy iterator over a directory tree\nreturned by `FS.walk`.\n\nDirectories are yielded before their content.\nIt is safe to use by multiple threads.", false, true, true, true, false, value.ToSymbol("Walker"), objectClass, env)
 			namespace.Name() // noop - avoid unused variable error
 		}
 		namespace.TryDefineClass("", false, true, true, true, false, value.ToSymbol("False"), objectClass, env)
@@ -546,6 +562,24 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 			namespace.Name() // noop - avoid unused variable error
 		}
 		namespace.TryDefineInterface("Represents a value that can compute its own hash for use in\ndata structures like hashmaps, hashrecords, hashsets.", value.ToSymbol("Hashable"), env)
+		{
+			namespace := namespace.TryDefineModule("Contains types used for reading and writing streams of data.\n\n```\nIO.stdin.each_line |line| -> IO.stderr.write(\"got: ${line}\\n\")\n```", value.ToSymbol("IO"), env)
+			namespace.TryDefineClass("Wraps a reader with a buffer\nand makes it possible to read lines.\nIt is safe to use by multiple threads.\nErrors of the wrapped reader are rethrown.\n\nIterating over a buffered reader yields its lines.\n\n```\nfor line in IO::BufferedReader(FS::File(\"notes.txt\"))\n  println line\nend\n```", false, true, true, false, false, value.ToSymbol("BufferedReader"), objectClass, env)
+			namespace.TryDefineClass("Wraps a writer with a buffer.\nIt is safe to use by multiple threads.\nErrors of the wrapped writer are rethrown.\n\nThe buffer should be flushed when you are done writing.\n\n```\nwriter := IO::BufferedWriter(IO.stdout)\nwriter.write(\"foo\\n\")\nwriter.flush\n```", false, true, true, false, false, value.ToSymbol("BufferedWriter"), objectClass, env)
+			namespace.TryDefineClass("Thrown when an I/O operation of a native stream fails.\nIt is unchecked since it is rare.", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
+			{
+				namespace := namespace.TryDefineInterface("Represents a source of data.", value.ToSymbol("Reader"), env)
+				namespace.Name() // noop - avoid unused variable error
+			}
+			namespace.TryDefineClass("Writes to the standard output or the standard error\nof the thread that calls `write`.\nReturned by `IO.stdout` and `IO.stderr`.", false, true, true, true, false, value.ToSymbol("StandardWriter"), objectClass, env)
+			namespace.TryDefineClass("An in-memory reader of a string.\nIt is safe to use by multiple threads.\n\n```\nreader := IO::StringReader(\"foo\\nbar\")\nIO::BufferedReader(reader).read_line #=> \"foo\"\n```", false, true, true, false, false, value.ToSymbol("StringReader"), objectClass, env)
+			namespace.TryDefineClass("An in-memory writer that builds a string.\nIt is safe to use by multiple threads.\n\n```\nwriter := IO::StringWriter()\nwriter.write(\"foo\")\nwriter.write(\"bar\")\nwriter.to_string #=> \"foobar\"\n```", false, true, true, false, false, value.ToSymbol("StringWriter"), objectClass, env)
+			{
+				namespace := namespace.TryDefineInterface("Represents a destination of data.", value.ToSymbol("Writer"), env)
+				namespace.Name() // noop - avoid unused variable error
+			}
+			namespace.Name() // noop - avoid unused variable error
+		}
 		{
 			namespace := namespace.TryDefineClass("ImmutableBox wraps another value, it's a read only pointer to another `Value`.", false, true, true, false, false, value.ToSymbol("ImmutableBox"), objectClass, env)
 			namespace.Name() // noop - avoid unused variable error
@@ -644,6 +678,12 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 		namespace.TryDefineClass("`Mixin` is the class of all mixins.", false, false, false, true, false, value.ToSymbol("Mixin"), objectClass, env)
 		namespace.TryDefineClass("`Module` is the class of all modules.", false, false, false, true, false, value.ToSymbol("Module"), objectClass, env)
 		namespace.TryDefineClass("Represents an empty value.", false, true, true, true, false, value.ToSymbol("Nil"), objectClass, env)
+		{
+			namespace := namespace.TryDefineModule("Contains functions for interacting\nwith the operating system and the current process.\n\n```\nname := OS.env[\"USER\"] ?? \"stranger\"\nprintln \"hello ${name}, you passed ${OS.args.length} arguments\"\n```", value.ToSymbol("OS"), env)
+			namespace.TryDefineClass("Provides access to the environment variables of the process.\nReturned by `OS.env`.\n\n```\nOS.env[\"EDITOR\"] = \"vim\"\nOS.env[\"EDITOR\"] #=> \"vim\"\nOS.env.contains(\"EDITOR\") #=> true\n```", false, true, true, true, false, value.ToSymbol("Environment"), objectClass, env)
+			namespace.TryDefineClass("Thrown when the operating system\nrefuses a request.\nIt is unchecked since it is rare.", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
+			namespace.Name() // noop - avoid unused variable error
+		}
 		namespace.TryDefineClass("", false, false, false, false, true, value.ToSymbol("Object"), objectClass, env)
 		namespace.TryDefineClass("Thrown when another thread tried to execute\nan open closure.\n\nAn open closure captures variables that still live\non the stack of the thread that created it.", false, false, false, false, false, value.ToSymbol("OpenClosureError"), objectClass, env)
 		{
@@ -668,7 +708,17 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 			namespace.Name() // noop - avoid unused variable error
 		}
 		{
-			namespace := namespace.TryDefineClass("A promise is the return type of a asynchronous function.\nIt is a placeholder for a value that will be available at some point\nin the future.", false, true, true, true, false, value.ToSymbol("Promise"), objectClass, env)
+			namespace := namespace.TryDefineClass("Represents a subprocess started by `Process.spawn`.\n\nUse `Process.run` to execute a command\nand capture its output.\n\n```\nresult := Process.run(\"git\", [\"status\", \"--short\"])\nprintln result.stdout if result.is_success\n```\n\nSpawned processes have pipes connected to\ntheir standard input, output and error.\nThe output should be read before waiting for the process,\nsince `wait` closes the pipes.\n\n```\nprocess := Process.spawn(\"sort\")\nprocess.stdin.write(\"b\\na\\n\")\nprocess.stdin.close\nprocess.stdout.read #=> \"a\\nb\\n\"\nprocess.wait #=> 0\n```\n\nProcesses get killed when the given aborter\n(by default the aborter of the current thread)\ngets closed.", false, true, true, true, false, value.ToSymbol("Process"), objectClass, env)
+			namespace.TryDefineClass("Thrown when a process cannot be started or waited for.\nIt is unchecked since it is rare.", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
+			namespace.TryDefineClass("Reads the standard output or standard error of a process.", false, true, true, true, false, value.ToSymbol("PipeReader"), objectClass, env)
+			namespace.TryDefineClass("Writes to the standard input of a process.", false, true, true, true, false, value.ToSymbol("PipeWriter"), objectClass, env)
+			namespace.TryDefineClass("The exit status and output of a process\nexecuted by `Process.run`.", false, true, true, true, false, value.ToSymbol("Result"), objectClass, env)
+			namespace.Name() // noop - avoid unused variable error
+		}
+		{
+			namespace := namespace.TryDefineClass("A promise is the return type of a asynchronous function.\nIt is a placeholder for a value that will be available at some point\nin the future.\n\nContinuations registered with methods like `map`, `then` and `catch`\nget executed in the thread pool of the current thread\nwhen the promise is settled, no thread is blocked while waiting.\nFunctions passed to them cannot capture local variables\nthat are still in use by the current thread,\notherwise they fail with `OpenClosureError`.", false, true, true, true, false, value.ToSymbol("Promise"), objectClass, env)
+			namespace.TryDefineClass("Thrown when all promises passed to `Promise.any` get rejected.", false, false, false, false, false, value.ToSymbol("AggregateError"), objectClass, env)
+			namespace.TryDefineClass("Thrown when a promise is not settled in time.", false, false, false, false, false, value.ToSymbol("TimeoutError"), objectClass, env)
 			namespace.Name() // noop - avoid unused variable error
 		}
 		{
@@ -683,7 +733,13 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 			namespace := namespace.TryDefineMixin("Represents an unordered immutable collection of key-value pairs.\nA record is an immutable map.", true, value.ToSymbol("Record"), env)
 			namespace.Name() // noop - avoid unused variable error
 		}
-		namespace.TryDefineClass("A `Regex` represents regular expression that can be used\nto match a pattern against strings.", false, true, true, false, false, value.ToSymbol("Regex"), objectClass, env)
+		{
+			namespace := namespace.TryDefineClass("A `Regex` represents regular expression that can be used\nto match a pattern against strings.\n\nPatterns that use lookaheads, lookbehinds, backreferences,\natomic groups or possessive quantifiers are executed\nby a backtracking engine.\nAn operation on such a pattern that takes too many steps\nthrows a `RegexStepLimitError`, including `=~`.\nMethods that find multiple matches share a single step budget.", false, true, true, false, false, value.ToSymbol("Regex"), objectClass, env)
+			namespace.TryDefineClass("A single match of a `Regex` in a string.\n\nCapture groups can be identified by their index\n(`0` is the entire match) or name.", false, true, true, true, false, value.ToSymbol("Match"), objectClass, env)
+			namespace.TryDefineClass("Iterates over successive matches of a `Regex` in a string.", false, true, true, true, false, value.ToSymbol("MatchIterator"), objectClass, env)
+			namespace.Name() // noop - avoid unused variable error
+		}
+		namespace.TryDefineClass("Thrown when a search of a backtracking `Regex`\ntakes too many steps, usually because of catastrophic backtracking.", false, false, false, false, false, value.ToSymbol("RegexStepLimitError"), objectClass, env)
 		{
 			namespace := namespace.TryDefineInterface("An interface that represents iterators that can be reset.", value.ToSymbol("ResettableIterator"), env)
 			{
@@ -727,23 +783,63 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 		namespace.TryDefineClass("Represents an interned string.\n\nA symbol is an integer ID that is associated\nwith a particular name (string).\n\nA few symbols with the same name refer to the same ID.\n\nComparing symbols happens in constant time, so it's\nusually faster than comparing strings.", false, true, true, true, false, value.ToSymbol("Symbol"), objectClass, env)
 		{
 			namespace := namespace.TryDefineModule("`Sync` provides synchronisation utilities like mutexes.", value.ToSymbol("Sync"), env)
+			namespace.TryDefineClass("An `AtomicBool` is a boolean that can be safely\nread and modified by multiple threads without a `Mutex`.", false, true, true, false, false, value.ToSymbol("AtomicBool"), objectClass, env)
+			namespace.TryDefineClass("An `AtomicInt` is an integer that can be safely\nread and modified by multiple threads without a `Mutex`.\n\nThe value is limited to the range of a signed 64-bit integer,\n`add` and `sub` wrap around on overflow.\n\n```\ncounter := Sync::AtomicInt()\nwg := Sync::WaitGroup(2)\ngo\n\tcounter.add(1)\n\twg.end\nend\ngo\n\tcounter.add(1)\n\twg.end\nend\nwg.wait\ncounter.load #=> 2\n```", false, true, true, false, false, value.ToSymbol("AtomicInt"), objectClass, env)
+			{
+				namespace := namespace.TryDefineClass("An `AtomicRef` holds a value that can be safely\nread and replaced by multiple threads without a `Mutex`.\n\nValues are compared by identity in `compare_and_swap`.\n\n```\nconfig := Sync::AtomicRef::[Config](load_config())\ngo\n\tloop\n\t\tsleep 1.minute\n\t\tconfig.store(load_config())\n\tend\nend\nconfig.load.port\n```", false, true, true, false, false, value.ToSymbol("AtomicRef"), objectClass, env)
+				namespace.Name() // noop - avoid unused variable error
+			}
+			namespace.TryDefineClass("An `AtomicUInt64` is a `UInt64` that can be safely\nread and modified by multiple threads without a `Mutex`.\n\n`add` and `sub` wrap around on overflow.", false, true, true, false, false, value.ToSymbol("AtomicUInt64"), objectClass, env)
+			namespace.TryDefineClass("A `Barrier` blocks threads until a fixed number of them,\ncalled parties, is waiting on it.\nAfterwards all of them are released and the barrier can be used again.\n\nIt is useful for computations that proceed in phases.\n\n```\nbarrier := Sync::Barrier(4)\n# in each of the 4 threads\ncompute_phase(1)\nbarrier.wait\ncompute_phase(2)\n```", false, true, true, false, false, value.ToSymbol("Barrier"), objectClass, env)
+			namespace.TryDefineClass("A `Cond` is a condition variable.\nIt lets threads wait until they are notified\nthat some condition might have changed.\n\nEvery `Cond` is tied to a `Mutex` that protects the condition.\n\n```\nmutex := Sync::Mutex()\ncond := Sync::Cond(mutex)\n\nmutex.lock\nuntil ready\n\tcond.wait\nend\nmutex.unlock\n```", false, true, true, false, false, value.ToSymbol("Cond"), objectClass, env)
+			namespace.TryDefineClass("A `CountDownLatch` blocks threads until its counter reaches zero.\n\nUnlike `Barrier` it cannot be reused.\nUnlike `WaitGroup` the counter cannot be incremented.\n\n```\nlatch := Sync::CountDownLatch(3)\n# in each of the 3 worker threads\ninitialise()\nlatch.count_down\n\n# in the main thread\nlatch.wait\n```", false, true, true, false, false, value.ToSymbol("CountDownLatch"), objectClass, env)
 			{
 				namespace := namespace.TryDefineClass("A thread safe `DiagnosticList`, synchronized with a Mutex.", false, true, true, false, false, value.ToSymbol("DiagnosticList"), objectClass, env)
 				namespace.TryDefineClass("", false, true, true, false, false, value.ToSymbol("Iterator"), objectClass, env)
 				namespace.Name() // noop - avoid unused variable error
 			}
+			{
+				namespace := namespace.TryDefineClass("A hash map that can be safely used by multiple threads\nwithout a `Mutex`.\n\nPairs are split into shards guarded by separate locks,\nso threads that use different keys rarely block each other.\n\n```\ncounts := Sync::Map::[String, Int]()\nwg := Sync::WaitGroup()\nwords.each |word|\n\tspawn! wg, go\n\t\tcounts.compute(word) |count| -> (count ?? 0) + 1\n\tend\nend\nwg.wait\n```\n\nThe functions passed to `get_or_insert`, `compute` and `remove_if`\nare called without holding any locks, so they may access the same map.", false, true, true, false, false, value.ToSymbol("Map"), objectClass, env)
+				namespace.Name() // noop - avoid unused variable error
+			}
 			namespace.TryDefineClass("A `Mutex` is a mutual exclusion lock.\nIt can be used to synchronise operations in multiple threads.", false, true, true, false, false, value.ToSymbol("Mutex"), objectClass, env)
 			namespace.TryDefineClass("`Once` is a kind of concurrent lock ensuring that a piece of\ncode will be executed exactly one time.", false, true, true, false, false, value.ToSymbol("Once"), objectClass, env)
+			{
+				namespace := namespace.TryDefineClass("An unbounded lock-free queue that can be safely used\nby multiple producer and consumer threads.\n\nUnlike `Channel` pushing never blocks\nand values can be popped without blocking with `try_pop`.\n\n```\njobs := Sync::Queue::[Job]()\ngo\n\tloop\n\t\tjob := jobs.pop\n\t\tjob.run\n\tend\nend\njobs << Job(\"a\") << Job(\"b\")\n```", false, true, true, false, false, value.ToSymbol("Queue"), objectClass, env)
+				namespace.Name() // noop - avoid unused variable error
+			}
 			namespace.TryDefineClass("Wraps a `RWMutex` and exposes its `read_lock` and `read_unlock`\nmethods as `lock` and `unlock` respectively.", false, true, true, false, false, value.ToSymbol("ROMutex"), objectClass, env)
 			namespace.TryDefineClass("A `Mutex` is a mutual exclusion lock that allows many readers or a single writer\nto hold the lock.", false, true, true, false, false, value.ToSymbol("RWMutex"), objectClass, env)
+			{
+				namespace := namespace.TryDefineClass("A `Semaphore` limits the number of threads\nthat can access a resource at the same time.\n\nEvery thread acquires a permit before accessing the resource\nand releases it when done.\n\n```\nsemaphore := Sync::Semaphore(3)\nsemaphore.acquire\ndo\n\tfetch_page()\nfinally\n\tsemaphore.release\nend\n```", false, true, true, false, false, value.ToSymbol("Semaphore"), objectClass, env)
+				namespace.TryDefineClass("Thrown when a semaphore without acquired permits gets released.", false, true, false, false, false, value.ToSymbol("ReleaseError"), objectClass, env)
+				namespace.Name() // noop - avoid unused variable error
+			}
+			{
+				namespace := namespace.TryDefineClass("A `TaskGroup` is a scope for child promises and threads.\n\nAll children share the aborter of the group.\nThe first child that fails cancels the group and all of its siblings.\n`wait` blocks until all children are done and throws\na `TaskGroup::Error` with the errors of the failed children.\n\nExample:\n\n\tusing Std::Sync::TaskGroup\n\n\tTaskGroup.run |group| ->\n\t\tgroup.spawn -> fetch_users()\n\t\tgroup.spawn -> fetch_orders()\n\t\tgroup.spawn_thread -> process_events()\n\tend\n\nFunctions passed to `spawn` and `spawn_thread` cannot capture local variables\nthat are still in use by the current thread,\notherwise they fail with `OpenClosureError`.", false, true, true, false, false, value.ToSymbol("TaskGroup"), objectClass, env)
+				namespace.TryDefineClass("Thrown by `wait` when children of the group have failed.", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
+				namespace.Name() // noop - avoid unused variable error
+			}
 			namespace.TryDefineClass("A `WaitGroup` waits for threads to finish.\n\nYou can use the `add` method to specify the amount of threads to wait for.\nAfterwards each thread should call `end` when finished\nThe `wait` method can be used to block until all threads have finished.", false, true, true, false, false, value.ToSymbol("WaitGroup"), objectClass, env)
 			namespace.Name() // noop - avoid unused variable error
 		}
-		namespace.TryDefineClass("Represents a single Elk thread of execution.", false, true, true, true, false, value.ToSymbol("Thread"), objectClass, env)
-		namespace.TryDefineClass("A pool of thread workers with a task queue.", false, true, true, true, false, value.ToSymbol("ThreadPool"), objectClass, env)
+		{
+			namespace := namespace.TryDefineClass("Represents a single Elk thread of execution.\n\nThreads started with `go` can be joined\nto get their result.\n\n```\nt := go 1 + 2\nt.join #=> 3\n```", false, true, true, true, false, value.ToSymbol("Thread"), objectClass, env)
+			namespace.TryDefineClass("Thrown by `join` when the thread\nterminated with an uncaught error\nor when it cannot be joined.", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
+			namespace.TryDefineClass("Thrown by `join` when the thread\nhas not finished before the timeout.", false, false, false, false, false, value.ToSymbol("TimeoutError"), objectClass, env)
+			namespace.Name() // noop - avoid unused variable error
+		}
+		{
+			namespace := namespace.TryDefineClass("A pool of thread workers with a task queue.\n\nAsync methods get executed by the pool of the calling thread\n(`ThreadPool::DEFAULT` by default).\nThey can be executed by another pool by passing it\nas the `_pool` argument:\n\n```\nio_pool := ThreadPool(threads: 16)\nfetch_users(_pool: io_pool)\n```", false, true, true, false, false, value.ToSymbol("ThreadPool"), objectClass, env)
+			namespace.TryDefineClass("Thrown when trying to execute a task in a closed thread pool.", false, false, false, false, false, value.ToSymbol("ClosedError"), objectClass, env)
+			namespace.TryDefineClass("Thrown when a thread pool cannot perform an operation.", false, false, false, false, false, value.ToSymbol("Error"), objectClass, env)
+			namespace.Name() // noop - avoid unused variable error
+		}
 		{
 			namespace := namespace.TryDefineClass("Represents a time of day with nanosecond precision.", false, true, true, false, false, value.ToSymbol("Time"), objectClass, env)
 			namespace.TryDefineClass("Represents the elapsed time between two Times as an int64 nanosecond count.\nThe representation limits the largest representable span to approximately 290 years.", false, true, true, false, false, value.ToSymbol("Span"), objectClass, env)
+			namespace.TryDefineClass("A `Ticker` sends the current datetime to its channel\nevery time the given time span elapses.\nTicks are dropped when the reader cannot keep up.\n\n```\nticker := Time::Ticker(1.second)\nloop\n\tselect\n\tcase <<ticker.channel\n\t\tprintln \"tick\"\n\tcase <<done\n\t\tticker.stop\n\t\tbreak\n\tend\nend\n```", false, true, true, false, false, value.ToSymbol("Ticker"), objectClass, env)
+			namespace.TryDefineClass("A `Timer` sends the current datetime to its channel\nonce after the given time span.\n\n```\ntimer := Time::Timer(5.seconds)\nselect\ncase v := <<ch\n\ttimer.stop\ncase <<timer.channel\n\tprintln \"timed out\"\nend\n```", false, true, true, false, false, value.ToSymbol("Timer"), objectClass, env)
 			namespace.Name() // noop - avoid unused variable error
 		}
 		namespace.TryDefineClass("Represents a timezone from the IANA Timezone database.", false, true, true, false, false, value.ToSymbol("Timezone"), objectClass, env)
@@ -849,6 +945,7 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 					// Define methods
 					namespace.DefineMethod("Create a new `Aborter` that is closed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("closed"), nil, nil, NameToType("Std::Aborter", env), Never{})
 					namespace.DefineMethod("Create a new `Aborter` that gets automatically closed at the given datetime.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("deadline"), nil, []*Parameter{NewParameter(value.ToSymbol("datetime"), NameToType("Std::DateTime", env), NormalParameterKind, false), NewParameter(value.ToSymbol("parent"), NameToType("Std::Aborter", env), DefaultValueParameterKind, false)}, NameToType("Std::Aborter", env), Never{})
+					namespace.DefineMethod("Create a new `Aborter` that gets automatically closed\nwhen one of the given signals is sent to the process.\n\n```\naborter := Aborter.on_signal([:int, :term])\n<<aborter.closed # blocks until the process receives SIGINT or SIGTERM\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("on_signal"), nil, []*Parameter{NewParameter(value.ToSymbol("signals"), NewUnion(NameToType("Std::Symbol", env), NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Symbol", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")}))), NormalParameterKind, false), NewParameter(value.ToSymbol("parent"), NameToType("Std::Aborter", env), DefaultValueParameterKind, false)}, NameToType("Std::Aborter", env), Never{})
 					namespace.DefineMethod("Create a new `Aborter` that gets automatically closed after the given time span.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("timeout"), nil, []*Parameter{NewParameter(value.ToSymbol("span"), NameToType("Std::Time::Span", env), NormalParameterKind, false), NewParameter(value.ToSymbol("parent"), NameToType("Std::Aborter", env), DefaultValueParameterKind, false)}, NameToType("Std::Aborter", env), Never{})
 
 					// Define constants
@@ -870,6 +967,107 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 					// Define instance variables
 				}
 			}
+			{
+				namespace := namespace.MustSubtypeString("Actor").(*Class)
+
+				namespace.Name() // noop - avoid unused variable error
+
+				// Set up type parameters
+				var typeParam *TypeParameter
+				typeParams := make([]*TypeParameter, 2)
+
+				typeParam = NewTypeParameter(value.ToSymbol("M"), namespace, Never{}, Any{}, nil, INVARIANT)
+				typeParams[0] = typeParam
+				namespace.DefineSubtype(value.ToSymbol("M"), typeParam)
+				namespace.DefineConstant(value.ToSymbol("M"), NoValue{})
+
+				typeParam = NewTypeParameter(value.ToSymbol("R"), namespace, Never{}, Any{}, nil, INVARIANT)
+				typeParams[1] = typeParam
+				namespace.DefineSubtype(value.ToSymbol("R"), typeParam)
+				namespace.DefineConstant(value.ToSymbol("R"), NoValue{})
+
+				namespace.SetTypeParameters(typeParams)
+
+				// Include mixins and implement interfaces
+
+				// Define methods
+				method = namespace.DefineMethod("Creates a new stateless actor.\n\n`receive` gets called with every message,\nits result is the reply to the message.\n\nThe mailbox holds 64 messages by default and at least 1,\nsending a message to a full mailbox blocks the current thread.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("receive"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("message"), NameToType("Std::Actor::M", env), NormalParameterKind, false)}, NameToType("Std::Actor::R", env), Never{}, false), NormalParameterKind, false), NewParameter(value.ToSymbol("mailbox_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("thread_pool"), NewNilable(NameToType("Std::ThreadPool", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("supervisor"), NewNilable(NameToType("Std::Actor::Supervisor", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Sends a message to the actor without waiting for a reply.\nThrows an unchecked `Actor::StoppedError` when the actor has been stopped\nand an unchecked `Actor::MailboxFullError` when the actor\nsends a message to itself while its mailbox is full.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("<<"), nil, []*Parameter{NewParameter(value.ToSymbol("message"), NameToType("Std::Actor::M", env), NormalParameterKind, false)}, Self{}, Never{})
+				namespace.DefineMethod("Sends a message to the actor.\nReturns a promise that gets resolved with the reply\nor rejected with the error thrown while processing the message.\n\nThrows an unchecked `Actor::StoppedError` when the actor has been stopped\nand an unchecked `Actor::MailboxFullError` when the actor\nsends a message to itself while its mailbox is full.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("ask"), nil, []*Parameter{NewParameter(value.ToSymbol("message"), NameToType("Std::Actor::M", env), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Actor::R", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+				namespace.DefineMethod("Whether the actor no longer accepts new messages.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_stopped"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Returns the number of messages waiting in the mailbox.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("mailbox_length"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Returns the number of times the actor has been restarted\nby its supervisor.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("restart_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Stops accepting new messages.\nMessages that are already in the mailbox still get processed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stop"), nil, nil, Void{}, Never{})
+				namespace.DefineMethod("Sends a message to the actor without waiting for a reply.\nThrows an unchecked `Actor::StoppedError` when the actor has been stopped\nand an unchecked `Actor::MailboxFullError` when the actor\nsends a message to itself while its mailbox is full.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("tell"), nil, []*Parameter{NewParameter(value.ToSymbol("message"), NameToType("Std::Actor::M", env), NormalParameterKind, false)}, Self{}, Never{})
+				namespace.DefineMethod("Blocks the current thread until the actor has stopped\nand processed all of its messages.\nThrows the error that made the actor stop.\n\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), nil, []*Parameter{NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+
+				// Define constants
+
+				// Define instance variables
+
+				{
+					namespace := namespace.Singleton()
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Creates a new actor with state.\n\n`state` gets called to create the state of the actor\nand every time the actor gets restarted by its supervisor.\n`receive` gets called with the state and a message,\nits result is the reply to the message.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("spawn"), []*TypeParameter{NewTypeParameter(value.ToSymbol("S"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("M"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("R"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("state"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, nil, NewTypeParameter(value.ToSymbol("S"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), Never{}, false), NormalParameterKind, false), NewParameter(value.ToSymbol("receive"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("state"), NewTypeParameter(value.ToSymbol("S"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("message"), NewTypeParameter(value.ToSymbol("M"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("R"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), Never{}, false), NormalParameterKind, false), NewParameter(value.ToSymbol("mailbox_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("thread_pool"), NewNilable(NameToType("Std::ThreadPool", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("supervisor"), NewNilable(NameToType("Std::Actor::Supervisor", env)), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::Actor", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("M"): NewTypeArgument(NewTypeParameter(value.ToSymbol("M"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), INVARIANT), value.ToSymbol("R"): NewTypeArgument(NewTypeParameter(value.ToSymbol("R"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("M"), value.ToSymbol("R")})), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("MailboxFullError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("StoppedError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Supervisor").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new supervisor.\nThe supervision window (`within`) is 5 seconds by default.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("strategy"), NameToType("Std::Symbol", env), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("max_restarts"), NameToType("Std::Int", env), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("within"), NewNilable(NameToType("Std::Time::Span", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the supervised actors.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("children"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewGeneric(NameToType("Std::Actor", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("M"): NewTypeArgument(Any{}, INVARIANT), value.ToSymbol("R"): NewTypeArgument(Any{}, INVARIANT)}, []value.Symbol{value.ToSymbol("M"), value.ToSymbol("R")})), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Returns the maximum number of restarts\nwithin the supervision window.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("max_restarts"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Stops all supervised actors.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stop"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Returns the name of the restart strategy.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("strategy"), nil, nil, NameToType("Std::Symbol", env), Never{})
+					namespace.DefineMethod("Blocks the current thread until all supervised actors have stopped.\nThrows the last error when the supervisor has given up.\n\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), nil, []*Parameter{NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the length of the supervision window.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("within"), nil, nil, NameToType("Std::Time::Span", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+			}
 			{
 				namespace := namespace.MustSubtypeString("ArrayList").(*Class)
 
@@ -1289,7 +1487,9 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 					// Include mixins and implement interfaces
 
 					// Define methods
+					namespace.DefineMethod("Returns a channel that receives the current datetime\nonce the given time span has elapsed.\n\nUseful for adding a timeout to `select`.\n\n```\nselect\ncase v := <<ch\n\tprintln v.unwrap\ncase <<Channel.after(1.second)\n\tprintln \"timed out\"\nend\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("after"), nil, []*Parameter{NewParameter(value.ToSymbol("span"), NameToType("Std::Time::Span", env), NormalParameterKind, false)}, NewGeneric(NameToType("Std::ReadChannel", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("V"): NewTypeArgument(NameToType("Std::DateTime", env), INVARIANT)}, []value.Symbol{value.ToSymbol("V")})), Never{})
 					namespace.DefineMethod("Create a new `Channel` that is closed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("closed"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :closed", true), Never{}, Any{}, nil, INVARIANT)}, nil, NewGeneric(NameToType("Std::Channel", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("V"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :closed", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("V")})), Never{})
+					namespace.DefineMethod("Returns a channel that receives the current datetime\nevery time the given time span elapses.\nTicks are dropped when the reader cannot keep up.\n\nThrows an unchecked `OutOfRangeError` when the span is not positive.\nUse `Time::Ticker` when the ticks need to be stopped.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("tick"), nil, []*Parameter{NewParameter(value.ToSymbol("span"), NameToType("Std::Time::Span", env), NormalParameterKind, false)}, NewGeneric(NameToType("Std::ReadChannel", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("V"): NewTypeArgument(NameToType("Std::DateTime", env), INVARIANT)}, []value.Symbol{value.ToSymbol("V")})), Never{})
 
 					// Define constants
 
@@ -1415,6 +1615,9 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 					// Define methods
 					namespace.DefineMethod("Returns the iterator for this range.\nOnly ranges of incrementable values can be iterated over.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("iter"), nil, nil, NewGeneric(NameToType("Std::ClosedRange::Iterator", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::ClosedRange::Val", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Calls `fn` for every element in parallel.\nWorks like `Iterable::FiniteBase#par_each`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_each"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::ClosedRange::Val", env), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Returns a new list with the results of calling `fn` for every element\nprocessed in parallel.\nWorks like `Iterable::FiniteBase#par_map`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::ClosedRange::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Reduces the elements to a single value in parallel.\nWorks like `Iterable::FiniteBase#par_reduce`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_reduce"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::ClosedRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::ClosedRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::ClosedRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::ClosedRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewNilable(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::ClosedRange::Val", env), Any{}, nil, INVARIANT)), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT))
 				}
 				IncludeMixinWithWhere(namespace, mixin, []*TypeParameter{NewTypeParameter(value.ToSymbol("Val"), mixin, Never{}, NewIntersection(NewGeneric(NameToType("Std::Incrementable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::ClosedRange::Val", env), COVARIANT)}, []value.Symbol{value.ToSymbol("T")})), NewGeneric(NameToType("Std::Comparable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::ClosedRange::Val", env), CONTRAVARIANT)}, []value.Symbol{value.ToSymbol("T")}))), nil, INVARIANT)})
 
@@ -2213,6 +2416,7 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Include mixins and implement interfaces
 
 				// Define methods
+				namespace.DefineMethod("Typecheck, compile and execute the given Elk source code.\nReturns the value of the last expression.\n\nThe code is typechecked against the global environment\nof the running program so it can use all of its classes,\nmodules and methods.\n\nValues from `bindings` are available in the evaluated\ncode as untyped local variables.\n\n```\nElk.eval(\"a + b * 2\", { a: 1, b: 5 }) #=> 11\n```\n\nClasses, modules and methods defined by the evaluated code\nare visible to code evaluated later in the same thread.\n\nBy default the code gets executed in the current thread.\nPass `new_thread: true` to execute it in a new thread,\nthe `Std::Thread` is returned immediately\nand its result can be retrieved with `Thread#join`.\n\n```\nthread := Elk.eval(\"2 * 3\", new_thread: true) as Thread\nthread.join #=> 6\n```\n\nAn `aborter` can be used to terminate the evaluated code,\nfor example after a timeout:\n\n```\nElk.eval(\"loop; end\", aborter: Aborter.timeout(1.second))\n```\n\nThrows `Std::Elk::Type::Checker::Error` when the code does not compile,\nits `diagnostics` contain the details.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("eval"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :eval", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("source"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("bindings"), NewNilable(NewGeneric(NameToType("Std::HashMap", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::Symbol", env), INVARIANT), value.ToSymbol("Value"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :eval", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("source_name"), NameToType("Std::String", env), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("new_thread"), Bool{}, DefaultValueParameterKind, false), NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Any{}, NameToType("Std::Elk::Type::Checker::Error", env))
 
 				// Define constants
 				namespace.DefineConstant(value.ToSymbol("VERSION"), NameToType("Std::String", env))
@@ -6985,6 +7189,20 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 						// Define instance variables
 
+						{
+							namespace := namespace.Singleton()
+
+							namespace.Name() // noop - avoid unused variable error
+
+							// Include mixins and implement interfaces
+
+							// Define methods
+							namespace.DefineMethod("Typecheck the given source code against the global environment\nof the running program without executing it.\n\nReturns a list of diagnostics (warnings, errors, info messages).", 0|METHOD_NATIVE_FLAG, value.ToSymbol("check"), nil, []*Parameter{NewParameter(value.ToSymbol("source"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("source_name"), NameToType("Std::String", env), DefaultValueParameterKind, false)}, NameToType("Std::DiagnosticList", env), Never{})
+
+							// Define constants
+
+							// Define instance variables
+						}
 						{
 							namespace := namespace.MustSubtypeString("Error").(*Class)
 
@@ -7177,11 +7395,142 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Include mixins and implement interfaces
 
 				// Define methods
+				namespace.DefineMethod("Appends `content` to the end of the file.\nThe file is created with `perm` permissions when it does not exist.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("append"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("perm"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+				method = namespace.DefineMethod("Returns a shallow copy of the module, which is the module itself.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("copy"), nil, nil, Self{}, Never{})
+				method.RegisterOverload(NewMethod("Copies the content and permissions of a file to a new path.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("copy"), nil, []*Parameter{NewParameter(value.ToSymbol("from"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("to"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env), namespace))
+				namespace.DefineMethod("Copies the content and permissions of a file to a new path.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("copy@1"), nil, []*Parameter{NewParameter(value.ToSymbol("from"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("to"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Reports whether a file or directory exists at the given path.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("exists"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, Bool{}, Never{})
+				namespace.DefineMethod("Returns a lazy iterator over the paths that match\nthe slash-separated `glob_pattern`.\n`**` matches any number of directories.\n\nDirectories that cannot be read are ignored.\n`ignore` and `symlinks` work like in `walk`.\n\n```\nfor path in FS.glob(\"src/**/*.elk\")\n  println path\nend\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("glob"), nil, []*Parameter{NewParameter(value.ToSymbol("glob_pattern"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("ignore"), NewNilable(NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("symlinks"), NameToType("Std::Symbol", env), DefaultValueParameterKind, false)}, NameToType("Std::FS::GlobIterator", env), NameToType("Std::GlobError", env))
+				namespace.DefineMethod("Returns information about the entries of a directory\nsorted by name.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("list_dir"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::FS::FileInfo", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Returns information about a file or directory.\nDescribes symbolic links instead of following them.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("lstat"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, NameToType("Std::FS::FileInfo", env), NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Creates a new directory in the temporary directory of the OS\nand returns its path.\nThe name of the directory starts with `prefix`\nfollowed by a random string.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("make_temp_dir"), nil, []*Parameter{NewParameter(value.ToSymbol("prefix"), NewNilable(NameToType("Std::String", env)), DefaultValueParameterKind, false)}, NameToType("Std::FS::Path", env), NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Creates a directory along with any missing parents.\nDoes nothing when the directory already exists.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("mkdir_all"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("perm"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Returns the content of the file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("read"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, NameToType("Std::String", env), NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("The asynchronous version of `read`.\nReturns a promise that gets resolved with the content of the file.\nThe file is read outside of thread pools, so awaiting\nthe promise does not block a thread worker.", 0|METHOD_NATIVE_FLAG|METHOD_ASYNC_FLAG, value.ToSymbol("read_async"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("_pool"), NameToType("Std::ThreadPool", env), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::FileSystemError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+				namespace.DefineMethod("Removes a file or an empty directory.\n\nWhen `recursive` is `true` directories get removed along with their content\nand missing paths are ignored.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("remove"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("recursive"), Bool{}, DefaultValueParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Moves a file or directory to a new path.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("rename"), nil, []*Parameter{NewParameter(value.ToSymbol("from"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("to"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Returns information about a file or directory.\nFollows symbolic links.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stat"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, NameToType("Std::FS::FileInfo", env), NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Returns a lazy iterator over the entries of the directory tree\nunder `root` in lexical order.\nThe root itself is not yielded.\n\nEntries whose paths relative to `root` match any of the `ignore` glob patterns\nare skipped along with their content.\nPatterns without a slash are matched against the names of entries.\n\n`symlinks` determines how symbolic links are treated:\n- `:no_follow` - yield them without following (default)\n- `:follow` - descend into links to directories\n- `:skip` - do not yield them at all\n\n```\nwalker := FS.walk(\"src\", ignore: [\".git\", \"*.tmp\"])\nfor entry in walker\n  if entry.name == \"vendor\"\n    walker.skip_dir\n    continue\n  end\n  println entry.path\nend\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("walk"), nil, []*Parameter{NewParameter(value.ToSymbol("root"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("ignore"), NewNilable(NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("symlinks"), NameToType("Std::Symbol", env), DefaultValueParameterKind, false)}, NameToType("Std::FS::Walker", env), NameToType("Std::GlobError", env))
+				namespace.DefineMethod("Writes `content` to the file.\nThe file is created with `perm` permissions when it does not exist,\notherwise it gets truncated.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("write"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("perm"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("The asynchronous version of `write`.\nThe file is written outside of thread pools, so awaiting\nthe promise does not block a thread worker.", 0|METHOD_NATIVE_FLAG|METHOD_ASYNC_FLAG, value.ToSymbol("write_async"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("perm"), NameToType("Std::Int", env), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("_pool"), NameToType("Std::ThreadPool", env), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Void{}, COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::FileSystemError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
 
 				// Define constants
 
 				// Define instance variables
 
+				{
+					namespace := namespace.MustSubtypeString("ClosedError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::FileSystemError", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("DirEntry").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Returns the depth of the entry.\nChildren of the root have depth `1`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("depth"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Returns information about the entry.\nFollowed symbolic links are described by their targets.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("info"), nil, nil, NameToType("Std::FS::FileInfo", env), NameToType("Std::FileSystemError", env))
+					namespace.DefineMethod("Reports whether the entry is a directory\nor a followed symbolic link to a directory.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_dir"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Reports whether the entry is a regular file\nor a followed symbolic link to a regular file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_file"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Reports whether the entry is a symbolic link.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_symlink"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Returns the base name of the entry.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("name"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the path of the entry including the root.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("path"), nil, nil, NameToType("Std::FS::Path", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("ExistsError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::FileSystemError", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("File").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					IncludeMixin(namespace, NewGeneric(NameToType("Std::Iterator::Base", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})))
+					ImplementInterface(namespace, NameToType("Std::Closable", env).(*Interface))
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::FileSystemError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::FileSystemError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+
+					// Define methods
+					method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("path"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("mode"), NameToType("Std::Symbol", env), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("perm"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+					namespace.DefineMethod("Flushes the buffered data and closes the file.\nClosing a closed file does nothing.\nI/O errors are thrown as unchecked `FileSystemError`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("close"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Calls `fn` with every remaining line of the file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("each_line"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :each_line", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("line"), NameToType("Std::String", env), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :each_line", true), Never{}, Any{}, nil, INVARIANT), false), NormalParameterKind, false)}, Void{}, NewUnion(NameToType("Std::FileSystemError", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :each_line", true), Never{}, Any{}, nil, INVARIANT)))
+					namespace.DefineMethod("Writes the buffered data to the file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("flush"), nil, nil, Void{}, NameToType("Std::FileSystemError", env))
+					namespace.DefineMethod("Reports whether the file has been closed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_closed"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Returns the mode the file was opened with.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("mode"), nil, nil, NameToType("Std::Symbol", env), Never{})
+					namespace.DefineMethod("Returns the next line of the file.\nI/O errors are thrown as unchecked `FileSystemError`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("next"), nil, nil, NameToType("Std::String", env), NewSymbolLiteral("stop_iteration"))
+					namespace.DefineMethod("Returns the path the file was opened with.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("path"), nil, nil, NameToType("Std::FS::Path", env), Never{})
+					namespace.DefineMethod("Reads up to `limit` bytes.\nReads the rest of the file when `limit` is `nil`.\nReturns an empty string at the end of the file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("read"), nil, []*Parameter{NewParameter(value.ToSymbol("limit"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NameToType("Std::String", env), NameToType("Std::FileSystemError", env))
+					namespace.DefineMethod("Reads the next line without the trailing line break.\nReturns `nil` when there are no more lines.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("read_line"), nil, nil, NewNilable(NameToType("Std::String", env)), NameToType("Std::FileSystemError", env))
+					namespace.DefineMethod("Returns information about the file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stat"), nil, nil, NameToType("Std::FS::FileInfo", env), NameToType("Std::FileSystemError", env))
+					namespace.DefineMethod("Writes `content` to the file.\nReturns the number of written bytes.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("write"), nil, []*Parameter{NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Int", env), NameToType("Std::FileSystemError", env))
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("FileInfo").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Reports whether it describes a directory.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_dir"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Reports whether it describes a regular file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_file"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Reports whether it describes a symbolic link.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_symlink"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Returns the Unix permission bits of the file eg. `0o644`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("mode"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Returns the time of the last modification.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("mtime"), nil, nil, NameToType("Std::DateTime", env), Never{})
+					namespace.DefineMethod("Returns the base name of the file.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("name"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the size of the file in bytes.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("size"), nil, nil, NameToType("Std::Int", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("GlobIterator").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					IncludeMixin(namespace, NewGeneric(NameToType("Std::Iterator::Base", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::FS::Path", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})))
+
+					// Define methods
+					namespace.DefineMethod("Returns the next matching path.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("next"), nil, nil, NameToType("Std::FS::Path", env), NewSymbolLiteral("stop_iteration"))
+					namespace.DefineMethod("Returns the glob pattern.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("pattern"), nil, nil, NameToType("Std::String", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
 				{
 					namespace := namespace.MustSubtypeString("Location").(*Class)
 
@@ -7198,6 +7547,20 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 					// Define instance variables
 				}
+				{
+					namespace := namespace.MustSubtypeString("NotFoundError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::FileSystemError", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
 				{
 					namespace := namespace.MustSubtypeString("Path").(*Class)
 
@@ -7256,6 +7619,37 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 						// Define instance variables
 					}
 				}
+				{
+					namespace := namespace.MustSubtypeString("PermissionError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::FileSystemError", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Walker").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					IncludeMixin(namespace, NewGeneric(NameToType("Std::Iterator::Base", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::FS::DirEntry", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::FileSystemError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})))
+
+					// Define methods
+					namespace.DefineMethod("Returns the next entry of the tree.\nA directory that cannot be read is reported as an error\nand the walk can be continued.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("next"), nil, nil, NameToType("Std::FS::DirEntry", env), NewUnion(NewSymbolLiteral("stop_iteration"), NameToType("Std::FileSystemError", env)))
+					namespace.DefineMethod("Returns the root of the walked tree.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("root"), nil, nil, NameToType("Std::FS::Path", env), Never{})
+					namespace.DefineMethod("Skips the content of the last yielded directory.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("skip_dir"), nil, nil, Void{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
 			}
 			{
 				namespace := namespace.MustSubtypeString("False").(*Class)
@@ -7846,33 +8240,206 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Define instance variables
 			}
 			{
-				namespace := namespace.MustSubtypeString("ImmutableBox").(*Class)
+				namespace := namespace.MustSubtypeString("IO").(*Module)
 
 				namespace.Name() // noop - avoid unused variable error
 
-				// Set up type parameters
-				var typeParam *TypeParameter
-				typeParams := make([]*TypeParameter, 1)
-
-				typeParam = NewTypeParameter(value.ToSymbol("Val"), namespace, Never{}, Any{}, nil, COVARIANT)
-				typeParams[0] = typeParam
-				namespace.DefineSubtype(value.ToSymbol("Val"), typeParam)
-				namespace.DefineConstant(value.ToSymbol("Val"), NoValue{})
-
-				namespace.SetTypeParameters(typeParams)
-
 				// Include mixins and implement interfaces
 
 				// Define methods
-				method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("v"), NameToType("Std::ImmutableBox::Val", env), NormalParameterKind, false)}, Void{}, Never{})
-				namespace.DefineMethod("Returns the raw address of the pointer as an unsigned integer.\n\nThis method is inherently UNSAFE\nand should only be used if you have a valid\nreason and you know what you're doing.\n\nIf all other references to an object disappear\nthe object will be garbage collected so you may end up\nwith a stale address of already freed memory.\nReading freed memory is undefined behaviour.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("address"), nil, nil, NameToType("Std::UInt", env), Never{})
-				namespace.DefineMethod("Retrieves the value stored in the `Box`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("get"), nil, nil, NameToType("Std::ImmutableBox::Val", env), Never{})
-				namespace.DefineMethod("Returns self.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("to_immutable_box"), nil, nil, NewGeneric(NameToType("Std::ImmutableBox", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::ImmutableBox::Val", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+				namespace.DefineMethod("Copies all data from `from` to `to`.\nReturns the number of copied bytes.\nErrors of the reader and the writer are rethrown.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("copy_stream"), nil, []*Parameter{NewParameter(value.ToSymbol("from"), NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})), NormalParameterKind, false), NewParameter(value.ToSymbol("to"), NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Returns a writer of the standard error.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stderr"), nil, nil, NameToType("Std::IO::StandardWriter", env), Never{})
+				namespace.DefineMethod("Returns a buffered reader of the standard input.\nThreads with the same standard input share the reader.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stdin"), nil, nil, NameToType("Std::IO::BufferedReader", env), Never{})
+				namespace.DefineMethod("Returns a writer of the standard output.\n`print`, `println` and `puts` write to it.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stdout"), nil, nil, NameToType("Std::IO::StandardWriter", env), Never{})
 
 				// Define constants
 
 				// Define instance variables
-			}
+
+				{
+					namespace := namespace.MustSubtypeString("BufferedReader").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					IncludeMixin(namespace, NewGeneric(NameToType("Std::Iterator::Base", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})))
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+
+					// Define methods
+					method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("source"), NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})), NormalParameterKind, false), NewParameter(value.ToSymbol("size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Calls `fn` with every remaining line.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("each_line"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :each_line", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("line"), NameToType("Std::String", env), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :each_line", true), Never{}, Any{}, nil, INVARIANT), false), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :each_line", true), Never{}, Any{}, nil, INVARIANT))
+					namespace.DefineMethod("Returns the next line.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("next"), nil, nil, NameToType("Std::String", env), NewSymbolLiteral("stop_iteration"))
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("read"), nil, []*Parameter{NewParameter(value.ToSymbol("limit"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Reads the next line without the trailing line break.\nReturns `nil` when there are no more lines.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("read_line"), nil, nil, NewNilable(NameToType("Std::String", env)), Never{})
+					namespace.DefineMethod("Returns the wrapped reader.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("source"), nil, nil, NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("BufferedWriter").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+
+					// Define methods
+					method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("target"), NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})), NormalParameterKind, false), NewParameter(value.ToSymbol("size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Writes the buffered data to the wrapped writer.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("flush"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Returns the wrapped writer.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("target"), nil, nil, NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("write"), nil, []*Parameter{NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Error").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Reader").(*Interface)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Set up type parameters
+					var typeParam *TypeParameter
+					typeParams := make([]*TypeParameter, 1)
+
+					typeParam = NewTypeParameter(value.ToSymbol("Err"), namespace, Never{}, Any{}, nil, COVARIANT)
+					typeParams[0] = typeParam
+					namespace.DefineSubtype(value.ToSymbol("Err"), typeParam)
+					namespace.DefineConstant(value.ToSymbol("Err"), NoValue{})
+					typeParam.Default = Never{}
+
+					namespace.SetTypeParameters(typeParams)
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Reads up to `limit` bytes.\nReads everything when `limit` is `nil`.\nReturns an empty string when there is no more data.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("read"), nil, []*Parameter{NewParameter(value.ToSymbol("limit"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NameToType("Std::String", env), NameToType("Std::IO::Reader::Err", env))
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("StandardWriter").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+
+					// Define methods
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("write"), nil, []*Parameter{NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("StringReader").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+
+					// Define methods
+					method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the number of unread bytes.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("length"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("read"), nil, []*Parameter{NewParameter(value.ToSymbol("limit"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NameToType("Std::String", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("StringWriter").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+
+					// Define methods
+					method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Returns the number of written bytes.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("length"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Returns the written data.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("to_string"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("write"), nil, []*Parameter{NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Writer").(*Interface)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Set up type parameters
+					var typeParam *TypeParameter
+					typeParams := make([]*TypeParameter, 1)
+
+					typeParam = NewTypeParameter(value.ToSymbol("Err"), namespace, Never{}, Any{}, nil, COVARIANT)
+					typeParams[0] = typeParam
+					namespace.DefineSubtype(value.ToSymbol("Err"), typeParam)
+					namespace.DefineConstant(value.ToSymbol("Err"), NoValue{})
+					typeParam.Default = Never{}
+
+					namespace.SetTypeParameters(typeParams)
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Writes `content` and returns the number of written bytes.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("write"), nil, []*Parameter{NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Int", env), NameToType("Std::IO::Writer::Err", env))
+
+					// Define constants
+
+					// Define instance variables
+				}
+			}
+			{
+				namespace := namespace.MustSubtypeString("ImmutableBox").(*Class)
+
+				namespace.Name() // noop - avoid unused variable error
+
+				// Set up type parameters
+				var typeParam *TypeParameter
+				typeParams := make([]*TypeParameter, 1)
+
+				typeParam = NewTypeParameter(value.ToSymbol("Val"), namespace, Never{}, Any{}, nil, COVARIANT)
+				typeParams[0] = typeParam
+				namespace.DefineSubtype(value.ToSymbol("Val"), typeParam)
+				namespace.DefineConstant(value.ToSymbol("Val"), NoValue{})
+
+				namespace.SetTypeParameters(typeParams)
+
+				// Include mixins and implement interfaces
+
+				// Define methods
+				method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("v"), NameToType("Std::ImmutableBox::Val", env), NormalParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Returns the raw address of the pointer as an unsigned integer.\n\nThis method is inherently UNSAFE\nand should only be used if you have a valid\nreason and you know what you're doing.\n\nIf all other references to an object disappear\nthe object will be garbage collected so you may end up\nwith a stale address of already freed memory.\nReading freed memory is undefined behaviour.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("address"), nil, nil, NameToType("Std::UInt", env), Never{})
+				namespace.DefineMethod("Retrieves the value stored in the `Box`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("get"), nil, nil, NameToType("Std::ImmutableBox::Val", env), Never{})
+				namespace.DefineMethod("Returns self.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("to_immutable_box"), nil, nil, NewGeneric(NameToType("Std::ImmutableBox", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::ImmutableBox::Val", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+
+				// Define constants
+
+				// Define instance variables
+			}
 			{
 				namespace := namespace.MustSubtypeString("ImmutableCollection").(*Interface)
 
@@ -8546,6 +9113,7 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				namespace.DefineMethod("Reduces the elements of this iterable to a single value by\niteratively combining each element with an initial value using the provided function.\n\nNever returns if the iterable is infinite.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("fold"), []*TypeParameter{NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, NameToType("Std::Iterable::Val", env), INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("initial"), NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, NameToType("Std::Iterable::Val", env), INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, NameToType("Std::Iterable::Val", env), INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NameToType("Std::Iterable::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, NameToType("Std::Iterable::Val", env), INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, NameToType("Std::Iterable::Val", env), INVARIANT), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::Err", env)))
 				namespace.DefineMethod("Returns the first index of element, or -1 if it could not be found.\n\nMay never return if the iterable is infinite.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("index_of"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :index_of", true), NameToType("Std::Iterable::Val", env), NameToType("Std::Iterable::Val", env), NameToType("Std::Iterable::Val", env), INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :index_of", true), NameToType("Std::Iterable::Val", env), NameToType("Std::Iterable::Val", env), NameToType("Std::Iterable::Val", env), INVARIANT), NormalParameterKind, false)}, NameToType("Std::Int", env), NameToType("Std::Iterable::Err", env))
 				namespace.DefineMethod("Checks whether the iterable is empty.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("is_empty"), nil, nil, Bool{}, NameToType("Std::Iterable::Err", env))
+				namespace.DefineMethod("Converts the elements of this iterable to strings\nand concatenates them putting `separator` between them.\n\nNever returns if the iterable is infinite.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("join"), nil, []*Parameter{NewParameter(value.ToSymbol("separator"), NameToType("Std::String", env), DefaultValueParameterKind, false)}, NameToType("Std::String", env), NameToType("Std::Iterable::Err", env))
 				namespace.DefineMethod("Returns the last element.\nThrows an error when the iterable is empty.\n\nNever returns if the iterable is infinite.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("last"), nil, nil, NameToType("Std::Iterable::Val", env), NewUnion(NameToType("Std::Iterable::NotFoundError", env), NameToType("Std::Iterable::Err", env)))
 				namespace.DefineMethod("Returns the number of elements present in the iterable.\n\nNever returns if the iterable is infinite.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("length"), nil, nil, NameToType("Std::Int", env), NameToType("Std::Iterable::Err", env))
 				namespace.DefineMethod("Iterates over the elements of this iterable,\nyielding them to the given closure.\n\nReturns a new iterable that consists of the elements returned\nby the given closure.\n\nNever returns if the iterable is infinite.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::Iterable::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::Iterable::Err", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::Err", env)))
@@ -8636,8 +9204,12 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("fold"), []*TypeParameter{NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("initial"), NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NameToType("Std::Iterable::FiniteBase::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("I"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, nil, INVARIANT), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :fold", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::FiniteBase::Err", env)))
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("index_of"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :index_of", true), NameToType("Std::Iterable::FiniteBase::Val", env), NameToType("Std::Iterable::FiniteBase::Val", env), NameToType("Std::Iterable::FiniteBase::Val", env), INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :index_of", true), NameToType("Std::Iterable::FiniteBase::Val", env), NameToType("Std::Iterable::FiniteBase::Val", env), NameToType("Std::Iterable::FiniteBase::Val", env), INVARIANT), NormalParameterKind, false)}, NameToType("Std::Int", env), NameToType("Std::Iterable::FiniteBase::Err", env))
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_empty"), nil, nil, Bool{}, NameToType("Std::Iterable::FiniteBase::Err", env))
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("join"), nil, []*Parameter{NewParameter(value.ToSymbol("separator"), NameToType("Std::String", env), DefaultValueParameterKind, false)}, NameToType("Std::String", env), NameToType("Std::Iterable::FiniteBase::Err", env))
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("last"), nil, nil, NameToType("Std::Iterable::FiniteBase::Val", env), NewUnion(NameToType("Std::Iterable::NotFoundError", env), NameToType("Std::Iterable::FiniteBase::Err", env)))
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::Iterable::FiniteBase::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::Iterable::FiniteBase::Err", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::FiniteBase::Err", env)))
+					namespace.DefineMethod("Calls `fn` for every element in parallel on the workers\nof the thread pool of the current thread.\nElements are not processed in order.\n\nWorks like `par_map`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_each"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::Iterable::FiniteBase::Val", env), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::FiniteBase::Err", env)))
+					namespace.DefineMethod("Returns a new list with the results of calling `fn` for every element.\nThe order of the results matches the order of the elements.\n\nElements are split into chunks of `chunk_size` elements\nthat get processed in parallel by the workers of the thread pool\nof the current thread.\nA suitable chunk size is picked when `chunk_size` is `nil`.\n\nThe first error cancels the remaining chunks and gets rethrown.\n`fn` may read local variables of the enclosing scope,\nit sees the values they had when `par_map` was called.\nThrows `Std::ArgumentError` when `fn` reassigns them.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::Iterable::FiniteBase::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::FiniteBase::Err", env)))
+					namespace.DefineMethod("Reduces the elements to a single value by combining them\nusing the provided function in parallel on the workers\nof the thread pool of the current thread.\nReturns `nil` when the iterable is empty.\n\nEvery chunk gets reduced separately and the partial results\nare then combined in order, so `fn` has to be associative.\n\nWorks like `par_map`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_reduce"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewNilable(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT)), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::FiniteBase::Err", env)))
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("reduce"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), NameToType("Std::Iterable::FiniteBase::Val", env), Any{}, nil, INVARIANT), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :reduce", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::FiniteBase::Err", env)))
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("reject"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :reject", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::Iterable::FiniteBase::Val", env), NormalParameterKind, false)}, Bool{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :reject", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Iterable::FiniteBase::Val", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::Iterable::FiniteBase::Err", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), NewUnion(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :reject", true), Never{}, Any{}, Never{}, INVARIANT), NameToType("Std::Iterable::FiniteBase::Err", env)))
 					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("take"), nil, []*Parameter{NewParameter(value.ToSymbol("n"), NameToType("Std::Int", env), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Iterable::FiniteBase::Val", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::Iterable::FiniteBase::Err", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), NameToType("Std::Iterable::FiniteBase::Err", env))
@@ -8777,7 +9349,7 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Include mixins and implement interfaces
 
 				// Define methods
-				namespace.DefineMethod("Causes the program to exit with the given status code.\nZero indicates success, non-zero an error.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("exit"), nil, []*Parameter{NewParameter(value.ToSymbol("code"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Causes the program to exit with the given status code.\nZero indicates success, non-zero an error.\n\nFunctions registered with `OS.at_exit` get called first.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("exit"), nil, []*Parameter{NewParameter(value.ToSymbol("code"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, Void{}, Never{})
 				method = namespace.DefineMethod("Converts the values to `String`\nand prints them to stdout.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("print"), nil, []*Parameter{NewParameter(value.ToSymbol("values"), NameToType("Std::String::Convertible", env), PositionalRestParameterKind, false)}, Void{}, Never{})
 				method.RegisterOverload(NewMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("print"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::String::Convertible", env), NormalParameterKind, false)}, Void{}, Never{}, namespace))
 				namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("print@1"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::String::Convertible", env), NormalParameterKind, false)}, Void{}, Never{})
@@ -8823,6 +9395,9 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 					// Define methods
 					namespace.DefineMethod("Returns the iterator for this range.\nOnly ranges of incrementable values can be iterated over.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("iter"), nil, nil, NewGeneric(NameToType("Std::LeftOpenRange::Iterator", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::LeftOpenRange::Val", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Calls `fn` for every element in parallel.\nWorks like `Iterable::FiniteBase#par_each`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_each"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::LeftOpenRange::Val", env), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Returns a new list with the results of calling `fn` for every element\nprocessed in parallel.\nWorks like `Iterable::FiniteBase#par_map`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::LeftOpenRange::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Reduces the elements to a single value in parallel.\nWorks like `Iterable::FiniteBase#par_reduce`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_reduce"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::LeftOpenRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::LeftOpenRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::LeftOpenRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::LeftOpenRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewNilable(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::LeftOpenRange::Val", env), Any{}, nil, INVARIANT)), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT))
 				}
 				IncludeMixinWithWhere(namespace, mixin, []*TypeParameter{NewTypeParameter(value.ToSymbol("Val"), mixin, Never{}, NewIntersection(NewGeneric(NameToType("Std::Incrementable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::LeftOpenRange::Val", env), COVARIANT)}, []value.Symbol{value.ToSymbol("T")})), NewGeneric(NameToType("Std::Comparable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::LeftOpenRange::Val", env), CONTRAVARIANT)}, []value.Symbol{value.ToSymbol("T")}))), nil, INVARIANT)})
 
@@ -9026,6 +9601,63 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 				// Define instance variables
 			}
+			{
+				namespace := namespace.MustSubtypeString("OS").(*Module)
+
+				namespace.Name() // noop - avoid unused variable error
+
+				// Include mixins and implement interfaces
+
+				// Define methods
+				namespace.DefineMethod("Returns the command-line arguments of the program.\n\nArguments after `--` get forwarded to the program\nby `elk run`.\n\n```\n# elk run main.elk -- foo bar\nOS.args #=> [\"foo\", \"bar\"]\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("args"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+				namespace.DefineMethod("Registers a function that gets called\nbefore the program exits, either normally or through `Kernel.exit`.\n\nThe functions get called in the reverse order of registration.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("at_exit"), nil, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, nil, Void{}, Never{}, false), NormalParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Changes the current working directory.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("chdir"), nil, []*Parameter{NewParameter(value.ToSymbol("dir"), NewUnion(NameToType("Std::String", env), NameToType("Std::FS::Path", env)), NormalParameterKind, false)}, Void{}, NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Returns the current working directory.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("cwd"), nil, nil, NameToType("Std::String", env), NameToType("Std::FileSystemError", env))
+				namespace.DefineMethod("Returns the environment variables of the process.\n\n```\nOS.env[\"HOME\"] #=> \"/home/elk\"\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("env"), nil, nil, NameToType("Std::OS::Environment", env), Never{})
+				namespace.DefineMethod("Returns a new map with all environment variables.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("env_map"), nil, nil, NewGeneric(NameToType("Std::HashMap", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::String", env), INVARIANT), value.ToSymbol("Value"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")})), Never{})
+				namespace.DefineMethod("Returns the host name of the machine.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("hostname"), nil, nil, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Calls `handler` in a new thread\nevery time one of the given signals is sent to the process.\nReturns the thread, cancelling it stops the handling.\n\n`handler` cannot capture local variables\nthat are still in use by the current thread,\notherwise it fails with `OpenClosureError`.\n\n```\nOS.on_signal(:hup, |signal| -> println \"reloading config\")\nOS.on_signal([:int, :term], |signal| -> Kernel.exit(1))\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("on_signal"), nil, []*Parameter{NewParameter(value.ToSymbol("signals"), NewUnion(NameToType("Std::Symbol", env), NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Symbol", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")}))), NormalParameterKind, false), NewParameter(value.ToSymbol("handler"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("signal"), NameToType("Std::Symbol", env), NormalParameterKind, false)}, Void{}, Never{}, false), NormalParameterKind, false)}, NameToType("Std::Thread", env), Never{})
+				namespace.DefineMethod("Returns the ID of the current process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("pid"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Sets the value of an environment variable.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("set_env"), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("value"), NameToType("Std::String", env), NormalParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Returns a channel that receives the names\nof the given signals sent to the process.\nThe default behaviour of the signals gets disabled\nuntil the channel is closed.\n\nValid signals are `:int`, `:term`, `:hup` and `:quit`.\n\n```\nsignals := OS.signals(:int, :term)\nselect\ncase signal := <<signals\n  println \"received ${signal.unwrap.inspect}, shutting down\"\ncase <<aborter.closed\n  println \"done\"\nend\nsignals.close\n```", 0|METHOD_NATIVE_FLAG, value.ToSymbol("signals"), nil, []*Parameter{NewParameter(value.ToSymbol("signals"), NameToType("Std::Symbol", env), PositionalRestParameterKind, false)}, NewGeneric(NameToType("Std::Channel", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("V"): NewTypeArgument(NameToType("Std::Symbol", env), INVARIANT)}, []value.Symbol{value.ToSymbol("V")})), Never{})
+				namespace.DefineMethod("Removes an environment variable.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("unset_env"), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::String", env), NormalParameterKind, false)}, Void{}, Never{})
+
+				// Define constants
+
+				// Define instance variables
+
+				{
+					namespace := namespace.MustSubtypeString("Environment").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Returns the value of the environment variable\nor `nil` when it is not set.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("[]"), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::String", env), NormalParameterKind, false)}, NewNilable(NameToType("Std::String", env)), Never{})
+					namespace.DefineMethod("Sets the value of the environment variable.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("[]="), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("value"), NameToType("Std::String", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Checks whether the environment variable is set.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("contains"), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::String", env), NormalParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Returns the value of the environment variable\nor `nil` when it is not set.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("get"), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::String", env), NormalParameterKind, false)}, NewNilable(NameToType("Std::String", env)), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Error").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+			}
 			{
 				namespace := namespace.MustSubtypeString("Object").(*Class)
 
@@ -9083,6 +9715,9 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 					// Define methods
 					namespace.DefineMethod("Returns the iterator for this range.\nOnly ranges of incrementable values can be iterated over.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("iter"), nil, nil, NewGeneric(NameToType("Std::OpenRange::Iterator", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::OpenRange::Val", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Calls `fn` for every element in parallel.\nWorks like `Iterable::FiniteBase#par_each`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_each"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::OpenRange::Val", env), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Returns a new list with the results of calling `fn` for every element\nprocessed in parallel.\nWorks like `Iterable::FiniteBase#par_map`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::OpenRange::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Reduces the elements to a single value in parallel.\nWorks like `Iterable::FiniteBase#par_reduce`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_reduce"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::OpenRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::OpenRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::OpenRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::OpenRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewNilable(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::OpenRange::Val", env), Any{}, nil, INVARIANT)), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT))
 				}
 				IncludeMixinWithWhere(namespace, mixin, []*TypeParameter{NewTypeParameter(value.ToSymbol("Val"), mixin, Never{}, NewIntersection(NewGeneric(NameToType("Std::Incrementable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::OpenRange::Val", env), COVARIANT)}, []value.Symbol{value.ToSymbol("T")})), NewGeneric(NameToType("Std::Comparable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::OpenRange::Val", env), CONTRAVARIANT)}, []value.Symbol{value.ToSymbol("T")}))), nil, INVARIANT)})
 
@@ -9247,31 +9882,24 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Define instance variables
 			}
 			{
-				namespace := namespace.MustSubtypeString("Promise").(*Class)
+				namespace := namespace.MustSubtypeString("Process").(*Class)
 
 				namespace.Name() // noop - avoid unused variable error
 
-				// Set up type parameters
-				var typeParam *TypeParameter
-				typeParams := make([]*TypeParameter, 2)
-
-				typeParam = NewTypeParameter(value.ToSymbol("Val"), namespace, Never{}, Any{}, nil, COVARIANT)
-				typeParams[0] = typeParam
-				namespace.DefineSubtype(value.ToSymbol("Val"), typeParam)
-				namespace.DefineConstant(value.ToSymbol("Val"), NoValue{})
-
-				typeParam = NewTypeParameter(value.ToSymbol("Err"), namespace, Never{}, Any{}, nil, COVARIANT)
-				typeParams[1] = typeParam
-				namespace.DefineSubtype(value.ToSymbol("Err"), typeParam)
-				namespace.DefineConstant(value.ToSymbol("Err"), NoValue{})
-				typeParam.Default = Never{}
-
-				namespace.SetTypeParameters(typeParams)
-
 				// Include mixins and implement interfaces
+				ImplementInterface(namespace, NameToType("Std::Closable", env).(*Interface))
 
 				// Define methods
-				namespace.DefineMethod("Check whether the promise is done.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_resolved"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Closes the pipes connected to the standard input,\noutput and error of the process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("close"), nil, nil, Void{}, Never{})
+				namespace.DefineMethod("Returns the path of the executed command.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("command"), nil, nil, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Kills the process immediately.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("kill"), nil, nil, Void{}, Never{})
+				namespace.DefineMethod("Returns the ID of the process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("pid"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Sends a signal to the process.\nValid signals are `:int`, `:term`, `:hup`, `:quit` and `:kill`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("signal"), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::Symbol", env), NormalParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Returns a reader of the standard error of the process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stderr"), nil, nil, NameToType("Std::Process::PipeReader", env), Never{})
+				namespace.DefineMethod("Returns a writer of the standard input of the process.\nIt should be closed to signal the end of input.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stdin"), nil, nil, NameToType("Std::Process::PipeWriter", env), Never{})
+				namespace.DefineMethod("Returns a reader of the standard output of the process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stdout"), nil, nil, NameToType("Std::Process::PipeReader", env), Never{})
+				namespace.DefineMethod("Closes the standard input, waits for the process to exit\nand returns its exit status.\nThe status is `-1` when the process has been terminated by a signal.\n\nStops waiting when the aborter of the current thread gets closed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("The asynchronous version of `wait`.\nReturns a promise that gets resolved with the exit status.\n\nUnlike `wait` it does not close the pipes,\nso the output can still be read after the process exits.\nCall `close` or `wait` afterwards to release them.", 0|METHOD_NATIVE_FLAG|METHOD_ASYNC_FLAG, value.ToSymbol("wait_async"), nil, []*Parameter{NewParameter(value.ToSymbol("_pool"), NameToType("Std::ThreadPool", env), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Int", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
 
 				// Define constants
 
@@ -9285,54 +9913,209 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 					// Include mixins and implement interfaces
 
 					// Define methods
-					namespace.DefineMethod("Creates a new promise that is immediately rejected with the given error.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("rejected"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :rejected", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("err"), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :rejected", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Never{}, COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :rejected", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
-					namespace.DefineMethod("Creates a new promise that is immediately resolved with the given result.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("resolved"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :resolved", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("result"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :resolved", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :resolved", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
-					namespace.DefineMethod("Returns a new promise that gets resolved when all given promises are resolved.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("promises"), NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), PositionalRestParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Executes the command with the given arguments,\nwaits for it to exit and returns its exit status and output.\n\n`env` contains variables that get added to the current environment.\n`stdin` is passed to the standard input of the process.\nThrows `Std::ExecutionAbortedError` when `aborter` gets closed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("run"), nil, []*Parameter{NewParameter(value.ToSymbol("command"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("args"), NewNilable(NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("env"), NewNilable(NewGeneric(NameToType("Std::Record", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::String", env), INVARIANT), value.ToSymbol("Value"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("cwd"), NewUnion(Nil{}, NameToType("Std::String", env), NameToType("Std::FS::Path", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("stdin"), NewUnion(Nil{}, NameToType("Std::String", env), NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, NameToType("Std::Process::Result", env), Never{})
+					namespace.DefineMethod("The asynchronous version of `run`.\nReturns a promise that gets resolved with the exit status and output.\nThe command is awaited outside of thread pools,\nso awaiting the promise does not block a thread worker.", 0|METHOD_NATIVE_FLAG|METHOD_ASYNC_FLAG, value.ToSymbol("run_async"), nil, []*Parameter{NewParameter(value.ToSymbol("command"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("args"), NewNilable(NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("env"), NewNilable(NewGeneric(NameToType("Std::Record", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::String", env), INVARIANT), value.ToSymbol("Value"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("cwd"), NewUnion(Nil{}, NameToType("Std::String", env), NameToType("Std::FS::Path", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("stdin"), NewUnion(Nil{}, NameToType("Std::String", env), NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Err")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("_pool"), NameToType("Std::ThreadPool", env), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Process::Result", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Starts the command with the given arguments\nand returns the new process without waiting for it.\n\n`env` contains variables that get added to the current environment.\nThe process gets killed when `aborter` gets closed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("spawn"), nil, []*Parameter{NewParameter(value.ToSymbol("command"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("args"), NewNilable(NewGeneric(NameToType("Std::Iterable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("env"), NewNilable(NewGeneric(NameToType("Std::Record", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::String", env), INVARIANT), value.ToSymbol("Value"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")}))), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("cwd"), NewUnion(Nil{}, NameToType("Std::String", env), NameToType("Std::FS::Path", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, NameToType("Std::Process", env), Never{})
 
 					// Define constants
 
 					// Define instance variables
 				}
-			}
-			{
-				namespace := namespace.MustSubtypeString("Range").(*Mixin)
+				{
+					namespace := namespace.MustSubtypeString("Error").(*Class)
 
-				namespace.Name() // noop - avoid unused variable error
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
 
-				// Set up type parameters
-				var typeParam *TypeParameter
-				typeParams := make([]*TypeParameter, 1)
+					// Include mixins and implement interfaces
 
-				typeParam = NewTypeParameter(value.ToSymbol("Element"), namespace, Never{}, Any{}, nil, INVARIANT)
-				typeParams[0] = typeParam
-				namespace.DefineSubtype(value.ToSymbol("Element"), typeParam)
-				namespace.DefineConstant(value.ToSymbol("Element"), NoValue{})
-				typeParam.UpperBound = NewGeneric(NameToType("Std::Comparable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::Range::Element", env), CONTRAVARIANT)}, []value.Symbol{value.ToSymbol("T")}))
+					// Define methods
 
-				namespace.SetTypeParameters(typeParams)
+					// Define constants
 
-				// Include mixins and implement interfaces
-				ImplementInterface(namespace, NewGeneric(NameToType("Std::Container", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Range::Element", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val")})))
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("PipeReader").(*Class)
 
-				// Define methods
-				namespace.DefineMethod("Returns the upper bound of the range.\nReturns `nil` if the range is endless.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("end"), nil, nil, NewNilable(NameToType("Std::Range::Element", env)), Never{})
-				namespace.DefineMethod("Returns `true` when the range is left-closed.\nOtherwise, the range is left-open.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("is_left_closed"), nil, nil, Bool{}, Never{})
-				namespace.DefineMethod("Returns `true` when the range is left-open.\nOtherwise, the range is left-closed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("is_left_open"), nil, nil, Bool{}, Never{})
-				namespace.DefineMethod("Returns `true` when the range is right-closed.\nOtherwise, the range is right-open.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("is_right_closed"), nil, nil, Bool{}, Never{})
-				namespace.DefineMethod("Returns `true` when the range is right-open.\nOtherwise, the range is right-closed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("is_right_open"), nil, nil, Bool{}, Never{})
-				namespace.DefineMethod("Returns the lower bound of the range.\nReturns `nil` if the Range is beginless.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("start"), nil, nil, NewNilable(NameToType("Std::Range::Element", env)), Never{})
+					namespace.Name() // noop - avoid unused variable error
 
-				// Define constants
+					// Include mixins and implement interfaces
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Reader", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::FileSystemError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+					ImplementInterface(namespace, NameToType("Std::Closable", env).(*Interface))
 
-				// Define instance variables
-			}
-			{
-				namespace := namespace.MustSubtypeString("ReadChannel").(*Class)
+					// Define methods
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("close"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("read"), nil, []*Parameter{NewParameter(value.ToSymbol("limit"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NameToType("Std::String", env), NameToType("Std::FileSystemError", env))
 
-				namespace.Name() // noop - avoid unused variable error
+					// Define constants
 
-				// Set up type parameters
-				var typeParam *TypeParameter
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("PipeWriter").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					ImplementInterface(namespace, NewGeneric(NameToType("Std::IO::Writer", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::FileSystemError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Err")})))
+					ImplementInterface(namespace, NameToType("Std::Closable", env).(*Interface))
+
+					// Define methods
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("close"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("write"), nil, []*Parameter{NewParameter(value.ToSymbol("content"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Int", env), NameToType("Std::FileSystemError", env))
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Result").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Whether the process exited with status `0`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_success"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Returns the exit status of the process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("status"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Returns the standard error of the process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stderr"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the standard output of the process.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stdout"), nil, nil, NameToType("Std::String", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+			}
+			{
+				namespace := namespace.MustSubtypeString("Promise").(*Class)
+
+				namespace.Name() // noop - avoid unused variable error
+
+				// Set up type parameters
+				var typeParam *TypeParameter
+				typeParams := make([]*TypeParameter, 2)
+
+				typeParam = NewTypeParameter(value.ToSymbol("Val"), namespace, Never{}, Any{}, nil, COVARIANT)
+				typeParams[0] = typeParam
+				namespace.DefineSubtype(value.ToSymbol("Val"), typeParam)
+				namespace.DefineConstant(value.ToSymbol("Val"), NoValue{})
+
+				typeParam = NewTypeParameter(value.ToSymbol("Err"), namespace, Never{}, Any{}, nil, COVARIANT)
+				typeParams[1] = typeParam
+				namespace.DefineSubtype(value.ToSymbol("Err"), typeParam)
+				namespace.DefineConstant(value.ToSymbol("Err"), NoValue{})
+				typeParam.Default = Never{}
+
+				namespace.SetTypeParameters(typeParams)
+
+				// Include mixins and implement interfaces
+
+				// Define methods
+				namespace.DefineMethod("Returns the aborter used by the async body of the promise,\n`nil` when the promise does not have a body.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("aborter"), nil, nil, NewNilable(NameToType("Std::Aborter", env)), Never{})
+				namespace.DefineMethod("Cancels the promise.\n\nThe aborter of the async body gets closed,\nso it terminates with an `execution aborted` error\nat its next safe point (eg. a loop iteration or `sleep`).\nPromises created by async methods in the body get cancelled as well.\n\nPromises without a body get rejected immediately.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("cancel"), nil, nil, Void{}, Never{})
+				namespace.DefineMethod("Calls the given function with the error of the promise when it gets rejected.\nThe returned promise gets resolved with the value returned by the function.\n\nResults are passed through without calling the function.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("catch"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :catch", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :catch", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("err"), NameToType("Std::Promise::Err", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :catch", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :catch", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewUnion(NameToType("Std::Promise::Val", env), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :catch", true), Never{}, Any{}, nil, INVARIANT)), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :catch", true), Never{}, Any{}, Never{}, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+				namespace.DefineMethod("Check whether the promise is done.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_resolved"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Calls the given function with the result of the promise when it gets resolved.\nThe returned promise gets resolved with the value returned by the function.\n\nErrors are passed through without calling the function.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Promise::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewUnion(NameToType("Std::Promise::Err", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :map", true), Never{}, Any{}, Never{}, INVARIANT)), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+				namespace.DefineMethod("Calls the given function with the result of the promise when it gets resolved.\nThe returned promise gets settled with the outcome of the promise returned by the function.\n\nErrors are passed through without calling the function.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("then"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :then", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :then", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Promise::Val", env), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :then", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :then", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{}, false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :then", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewUnion(NameToType("Std::Promise::Err", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :then", true), Never{}, Any{}, nil, INVARIANT)), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+				namespace.DefineMethod("Returns a new promise that gets settled with the outcome of this promise\nor rejected with `Promise::TimeoutError` when this promise is not settled\nbefore the timeout.\n\nThis promise is left running after the timeout,\nso other code awaiting it is not affected.\nPass `cancel: true` to cancel it instead.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("with_timeout"), nil, []*Parameter{NewParameter(value.ToSymbol("timeout"), NameToType("Std::Time::Span", env), NormalParameterKind, false), NewParameter(value.ToSymbol("cancel"), Bool{}, DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Promise::Val", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewUnion(NameToType("Std::Promise::Err", env), NameToType("Std::Promise::TimeoutError", env)), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+
+				// Define constants
+
+				// Define instance variables
+
+				{
+					namespace := namespace.Singleton()
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Returns a new promise that gets resolved when all given promises are settled.\nThe result is a list of `Result`s of the promises in the order they were given.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("all_settled"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :all_settled", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :all_settled", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("promises"), NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :all_settled", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :all_settled", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), PositionalRestParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewGeneric(NameToType("Std::Result", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :all_settled", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :all_settled", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Returns a new promise that gets resolved with the result of the first resolved promise.\nWhen all promises get rejected it gets rejected with a `Promise::AggregateError`\nthat contains their errors.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("any"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :any", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :any", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("promises"), NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :any", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :any", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), PositionalRestParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :any", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NameToType("Std::Promise::AggregateError", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Returns a new promise that gets settled with the outcome of the first settled promise.\n\nThrows `Std::OutOfRangeError` when no promises are given.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("race"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :race", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :race", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("promises"), NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :race", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :race", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), PositionalRestParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :race", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :race", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Creates a new promise that is immediately rejected with the given error.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("rejected"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :rejected", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("err"), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :rejected", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Never{}, COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :rejected", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Creates a new promise that is immediately resolved with the given result.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("resolved"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :resolved", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("result"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :resolved", true), Never{}, Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :resolved", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Returns a new promise that gets resolved when all given promises are resolved.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("promises"), NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), PositionalRestParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :wait", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("AggregateError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("message"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("errors"), NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NormalParameterKind, false)}, Void{}, Never{})
+					ivars := method.InitialisedInstanceVariables
+					ivars.Add(value.ToSymbol("errors"))
+					namespace.DefineMethod("Returns the errors of the rejected promises.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("errors"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+
+					// Define constants
+
+					// Define instance variables
+					namespace.DefineInstanceVariable(value.ToSymbol("errors"), NewInstanceVariable(value.ToSymbol("errors"), NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), "", false))
+				}
+				{
+					namespace := namespace.MustSubtypeString("TimeoutError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+			}
+			{
+				namespace := namespace.MustSubtypeString("Range").(*Mixin)
+
+				namespace.Name() // noop - avoid unused variable error
+
+				// Set up type parameters
+				var typeParam *TypeParameter
+				typeParams := make([]*TypeParameter, 1)
+
+				typeParam = NewTypeParameter(value.ToSymbol("Element"), namespace, Never{}, Any{}, nil, INVARIANT)
+				typeParams[0] = typeParam
+				namespace.DefineSubtype(value.ToSymbol("Element"), typeParam)
+				namespace.DefineConstant(value.ToSymbol("Element"), NoValue{})
+				typeParam.UpperBound = NewGeneric(NameToType("Std::Comparable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::Range::Element", env), CONTRAVARIANT)}, []value.Symbol{value.ToSymbol("T")}))
+
+				namespace.SetTypeParameters(typeParams)
+
+				// Include mixins and implement interfaces
+				ImplementInterface(namespace, NewGeneric(NameToType("Std::Container", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Range::Element", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Val")})))
+
+				// Define methods
+				namespace.DefineMethod("Returns the upper bound of the range.\nReturns `nil` if the range is endless.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("end"), nil, nil, NewNilable(NameToType("Std::Range::Element", env)), Never{})
+				namespace.DefineMethod("Returns `true` when the range is left-closed.\nOtherwise, the range is left-open.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("is_left_closed"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Returns `true` when the range is left-open.\nOtherwise, the range is left-closed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("is_left_open"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Returns `true` when the range is right-closed.\nOtherwise, the range is right-open.", 0|METHOD_ABSTRACT_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("is_right_closed"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Returns `true` when the range is right-open.\nOtherwise, the range is right-closed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("is_right_open"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Returns the lower bound of the range.\nReturns `nil` if the Range is beginless.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("start"), nil, nil, NewNilable(NameToType("Std::Range::Element", env)), Never{})
+
+				// Define constants
+
+				// Define instance variables
+			}
+			{
+				namespace := namespace.MustSubtypeString("ReadChannel").(*Class)
+
+				namespace.Name() // noop - avoid unused variable error
+
+				// Set up type parameters
+				var typeParam *TypeParameter
 				typeParams := make([]*TypeParameter, 1)
 
 				typeParam = NewTypeParameter(value.ToSymbol("V"), namespace, Never{}, Any{}, nil, INVARIANT)
@@ -9423,7 +10206,18 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Define methods
 				namespace.DefineMethod("Creates a new `Regex` that contains the\npattern of `self` repeated `n` times.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("*"), nil, []*Parameter{NewParameter(value.ToSymbol("n"), NameToType("Std::Int", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Create a new regex that contains\nthe patterns present in both operands.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("+"), nil, []*Parameter{NewParameter(value.ToSymbol("other"), NameToType("Std::Regex", env), NormalParameterKind, false)}, NameToType("Std::Regex", env), Never{})
+				namespace.DefineMethod("Check whether the pattern matches\nthe given string.\n\nWhen `other` is a `Regex` checks\nwhether both regexes are equal.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("=~"), nil, []*Parameter{NewParameter(value.ToSymbol("other"), Any{}, NormalParameterKind, false)}, Bool{}, Never{})
+				namespace.DefineMethod("Returns an iterator over all successive,\nnon-overlapping matches of the pattern in the given string.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("find_all"), nil, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Regex::MatchIterator", env), Never{})
+				namespace.DefineMethod("Returns the first match of the pattern in the given string\nor `nil` when the pattern doesn't match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("match"), nil, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false)}, NewNilable(NameToType("Std::Regex::Match", env)), Never{})
 				namespace.DefineMethod("Check whether the pattern matches\nthe given string.\n\nReturns `true` if it matches, otherwise `false`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("matches"), nil, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Bool", env), Never{})
+				method = namespace.DefineMethod("Create a new string with the first match\nof the pattern in `str` replaced with `replacement`.\n\n`replacement` can refer to capture groups with `$1` or `${name}`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("replace"), nil, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				method.RegisterOverload(NewMethod("Create a new string with the first match\nof the pattern in `str` replaced with the result\nof calling `replacement` with the match.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("replace"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("m"), NameToType("Std::Regex::Match", env), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace", true), Never{}, Any{}, nil, INVARIANT), false), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace", true), Never{}, Any{}, nil, INVARIANT), namespace))
+				namespace.DefineMethod("Create a new string with the first match\nof the pattern in `str` replaced with the result\nof calling `replacement` with the match.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("replace@1"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("m"), NameToType("Std::Regex::Match", env), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace", true), Never{}, Any{}, nil, INVARIANT), false), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace", true), Never{}, Any{}, nil, INVARIANT))
+				method = namespace.DefineMethod("Create a new string with all matches\nof the pattern in `str` replaced with `replacement`.\n\n`replacement` can refer to capture groups with `$1` or `${name}`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("replace_all"), nil, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				method.RegisterOverload(NewMethod("Create a new string with all matches\nof the pattern in `str` replaced with the results\nof calling `replacement` with every match.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("replace_all"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace_all", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("m"), NameToType("Std::Regex::Match", env), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace_all", true), Never{}, Any{}, nil, INVARIANT), false), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace_all", true), Never{}, Any{}, nil, INVARIANT), namespace))
+				namespace.DefineMethod("Create a new string with all matches\nof the pattern in `str` replaced with the results\nof calling `replacement` with every match.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("replace_all@1"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace_all", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("m"), NameToType("Std::Regex::Match", env), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace_all", true), Never{}, Any{}, nil, INVARIANT), false), NormalParameterKind, false)}, NameToType("Std::String", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :replace_all", true), Never{}, Any{}, nil, INVARIANT))
+				namespace.DefineMethod("Returns an iterator over all successive,\nnon-overlapping matches of the pattern in the given string.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("scan"), nil, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::Regex::MatchIterator", env), Never{})
+				namespace.DefineMethod("Split the given string into substrings\nseparated by matches of the pattern.\n\n`limit` is the maximum number of substrings.\nWhen it's negative all substrings are returned.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("split"), nil, []*Parameter{NewParameter(value.ToSymbol("str"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("limit"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
 				namespace.DefineMethod("Returns the AST Node that represents the same value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_ast_expr_node"), nil, nil, NameToType("Std::Elk::AST::UninterpolatedRegexLiteralNode", env), Never{})
 				namespace.DefineMethod("Returns the AST Node that represents the same value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_ast_node"), nil, nil, NameToType("Std::Elk::AST::UninterpolatedRegexLiteralNode", env), Never{})
 				namespace.DefineMethod("Returns the AST Node that represents the same value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_ast_pattern_expr_node"), nil, nil, NameToType("Std::Elk::AST::UninterpolatedRegexLiteralNode", env), Never{})
@@ -9433,6 +10227,67 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Define constants
 
 				// Define instance variables
+
+				{
+					namespace := namespace.MustSubtypeString("Match").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Returns the text of the given capture group\nor `nil` when the group did not participate in the match.\n\nThrows an `IndexError` when the regex has no such group.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("[]"), nil, []*Parameter{NewParameter(value.ToSymbol("group"), NewUnion(NameToType("Std::Int", env), NameToType("Std::String", env), NameToType("Std::Symbol", env)), NormalParameterKind, false)}, NewNilable(NameToType("Std::String", env)), Never{})
+					namespace.DefineMethod("Returns the text of the string after the match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("after"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the text of the string before the match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("before"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the index of the byte after the end of the given capture group\nor `nil` when the group did not participate in the match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("byte_end"), nil, []*Parameter{NewParameter(value.ToSymbol("group"), NewUnion(NameToType("Std::Int", env), NameToType("Std::String", env), NameToType("Std::Symbol", env)), DefaultValueParameterKind, false)}, NewNilable(NameToType("Std::Int", env)), Never{})
+					namespace.DefineMethod("Returns the index of the byte at which the given capture group begins\nor `nil` when the group did not participate in the match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("byte_start"), nil, []*Parameter{NewParameter(value.ToSymbol("group"), NewUnion(NameToType("Std::Int", env), NameToType("Std::String", env), NameToType("Std::Symbol", env)), DefaultValueParameterKind, false)}, NewNilable(NameToType("Std::Int", env)), Never{})
+					namespace.DefineMethod("Returns a list of the texts of all capture groups\nnot counting the entire match.\nGroups that did not participate in the match are `nil`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("captures"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewNilable(NameToType("Std::String", env)), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Returns the index of the char after the end of the given capture group\nor `nil` when the group did not participate in the match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("char_end"), nil, []*Parameter{NewParameter(value.ToSymbol("group"), NewUnion(NameToType("Std::Int", env), NameToType("Std::String", env), NameToType("Std::Symbol", env)), DefaultValueParameterKind, false)}, NewNilable(NameToType("Std::Int", env)), Never{})
+					namespace.DefineMethod("Returns the index of the char at which the given capture group begins\nor `nil` when the group did not participate in the match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("char_start"), nil, []*Parameter{NewParameter(value.ToSymbol("group"), NewUnion(NameToType("Std::Int", env), NameToType("Std::String", env), NameToType("Std::Symbol", env)), DefaultValueParameterKind, false)}, NewNilable(NameToType("Std::Int", env)), Never{})
+					namespace.DefineMethod("Expand a template that refers to\ncapture groups with `$1` or `${name}`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("expand"), nil, []*Parameter{NewParameter(value.ToSymbol("template"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the text of the given capture group\nor `nil` when the group did not participate in the match.\n\nThrows an `IndexError` when the regex has no such group.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("group"), nil, []*Parameter{NewParameter(value.ToSymbol("group"), NewUnion(NameToType("Std::Int", env), NameToType("Std::String", env), NameToType("Std::Symbol", env)), NormalParameterKind, false)}, NewNilable(NameToType("Std::String", env)), Never{})
+					namespace.DefineMethod("Returns the number of capture groups of the regex\nnot counting the entire match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("group_count"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Returns a map of the texts of all named capture groups.\nGroups that did not participate in the match are `nil`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("named_captures"), nil, nil, NewGeneric(NameToType("Std::HashMap", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::String", env), INVARIANT), value.ToSymbol("Value"): NewTypeArgument(NewNilable(NameToType("Std::String", env)), INVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")})), Never{})
+					namespace.DefineMethod("Returns the regex that produced this match.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("regex"), nil, nil, NameToType("Std::Regex", env), Never{})
+					namespace.DefineMethod("Returns the string in which the match has been found.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("string"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the matched text.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_string"), nil, nil, NameToType("Std::String", env), Never{})
+					namespace.DefineMethod("Returns the matched text.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("value"), nil, nil, NameToType("Std::String", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("MatchIterator").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+					IncludeMixin(namespace, NewGeneric(NameToType("Std::Iterator::Base", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Regex::Match", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})))
+
+					// Define methods
+					namespace.DefineMethod("Returns itself.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("iter"), nil, nil, NameToType("Std::Regex::MatchIterator", env), Never{})
+					namespace.DefineMethod("Get the next match.\nThrows `:stop_iteration` when no more matches are available.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("next"), nil, nil, NameToType("Std::Regex::Match", env), NewSymbolLiteral("stop_iteration"))
+					namespace.DefineMethod("Resets the state of the iterator.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("reset"), nil, nil, Void{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+			}
+			{
+				namespace := namespace.MustSubtypeString("RegexStepLimitError").(*Class)
+
+				namespace.Name() // noop - avoid unused variable error
+				namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+				// Include mixins and implement interfaces
+
+				// Define methods
+
+				// Define constants
+
+				// Define instance variables
 			}
 			{
 				namespace := namespace.MustSubtypeString("ResettableIterator").(*Interface)
@@ -9580,6 +10435,9 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 					// Define methods
 					namespace.DefineMethod("Returns the iterator for this range.\nOnly ranges of incrementable values can be iterated over.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("iter"), nil, nil, NewGeneric(NameToType("Std::RightOpenRange::Iterator", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::RightOpenRange::Val", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Calls `fn` for every element in parallel.\nWorks like `Iterable::FiniteBase#par_each`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_each"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::RightOpenRange::Val", env), NormalParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_each", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Returns a new list with the results of calling `fn` for every element\nprocessed in parallel.\nWorks like `Iterable::FiniteBase#par_map`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_map"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("element"), NameToType("Std::RightOpenRange::Val", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, nil, INVARIANT), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_map", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Reduces the elements to a single value in parallel.\nWorks like `Iterable::FiniteBase#par_reduce`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("par_reduce"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::RightOpenRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("accum"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::RightOpenRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false), NewParameter(value.ToSymbol("element"), NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::RightOpenRange::Val", env), Any{}, nil, INVARIANT), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::RightOpenRange::Val", env), Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false), NewParameter(value.ToSymbol("chunk_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, NewNilable(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), NameToType("Std::RightOpenRange::Val", env), Any{}, nil, INVARIANT)), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :par_reduce", true), Never{}, Any{}, Never{}, INVARIANT))
 				}
 				IncludeMixinWithWhere(namespace, mixin, []*TypeParameter{NewTypeParameter(value.ToSymbol("Val"), mixin, Never{}, NewIntersection(NewGeneric(NameToType("Std::Incrementable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::RightOpenRange::Val", env), COVARIANT)}, []value.Symbol{value.ToSymbol("T")})), NewGeneric(NameToType("Std::Comparable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(NameToType("Std::RightOpenRange::Val", env), CONTRAVARIANT)}, []value.Symbol{value.ToSymbol("T")}))), nil, INVARIANT)})
 
@@ -9718,6 +10576,7 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				ImplementInterface(namespace, NewGeneric(NameToType("Std::Comparable", env).(*Interface), NewTypeArguments(TypeArgumentMap{value.ToSymbol("T"): NewTypeArgument(Self{}, CONTRAVARIANT)}, []value.Symbol{value.ToSymbol("T")})))
 
 				// Define methods
+				namespace.DefineMethod("Create a new `String` by formatting the arguments\naccording to `self`, see `String.format`.\n\n`args` may be a single value or a tuple of values.\n\n\t\"%05.1f%%\" % 12.345 #=> \"012.3%\"\n\t\"%s is %d\" % [\"foo\", 3] #=> \"foo is 3\"", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG, value.ToSymbol("%"), nil, []*Parameter{NewParameter(value.ToSymbol("args"), Any{}, NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Creates a new `String` that contains the\ncontent of `self` repeated `n` times.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("*"), nil, []*Parameter{NewParameter(value.ToSymbol("n"), NameToType("Std::Int", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Concatenate this `String`\nwith another `String` or `Char`.\n\nCreates a new `String` containing the content\nof both operands.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("+"), nil, []*Parameter{NewParameter(value.ToSymbol("other"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Remove the given suffix from the `String`.\n\nDoes nothing if the `String` doesn't end\nwith `suffix` and returns `self`.\n\nIf the `String` ends with the given suffix\na new `String` gets created and returned that doesn't contain\nthe suffix.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("-"), nil, []*Parameter{NewParameter(value.ToSymbol("suffix"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
@@ -9725,27 +10584,50 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				namespace.DefineMethod("", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("<="), nil, []*Parameter{NewParameter(value.ToSymbol("other"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, Bool{}, Never{})
 				namespace.DefineMethod("", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("<=>"), nil, []*Parameter{NewParameter(value.ToSymbol("other"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
 				namespace.DefineMethod("", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("=="), nil, []*Parameter{NewParameter(value.ToSymbol("other"), Any{}, NormalParameterKind, false)}, Bool{}, Never{})
+				namespace.DefineMethod("Check whether `self` is equal to `other`.\n\nWhen `other` is a `Regex` checks\nwhether it matches `self`.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("=~"), nil, []*Parameter{NewParameter(value.ToSymbol("other"), Any{}, NormalParameterKind, false)}, Bool{}, Never{})
 				namespace.DefineMethod("", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol(">"), nil, []*Parameter{NewParameter(value.ToSymbol("other"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, Bool{}, Never{})
 				namespace.DefineMethod("", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol(">="), nil, []*Parameter{NewParameter(value.ToSymbol("other"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, Bool{}, Never{})
 				namespace.DefineMethod("Get the byte with the given index.\nIndices start at 0.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("byte_at"), nil, []*Parameter{NewParameter(value.ToSymbol("index"), NameToType("Std::AnyInt", env), NormalParameterKind, false)}, NameToType("Std::UInt8", env), Never{})
 				namespace.DefineMethod("Get the number of bytes that this\nstring contains.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("byte_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Returns the index of the byte at which the first match\nof the given pattern begins.\nThe search starts at the byte with index `start`,\nnegative indices are counted from the end of the `String`.\n\nReturns `-1` when the pattern cannot be found.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("byte_index_of"), nil, []*Parameter{NewParameter(value.ToSymbol("search"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("start"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, NameToType("Std::Int", env), Never{})
 				namespace.DefineMethod("Iterates over all bytes of a `String`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("byte_iter"), nil, nil, NameToType("Std::String::ByteIterator", env), Never{})
+				namespace.DefineMethod("Returns a list of all bytes of the `String`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("bytes"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::UInt8", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+				namespace.DefineMethod("Create a new string with the first char\nturned into uppercase and the rest into lowercase.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("capitalize"), nil, nil, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Create a new string centered\nin a string of the given length using the given char for padding.\n\nWhen the padding can't be split evenly\nthe right side gets one more char.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("center"), nil, []*Parameter{NewParameter(value.ToSymbol("len"), NameToType("Std::Int", env), NormalParameterKind, false), NewParameter(value.ToSymbol("padding"), NameToType("Std::Char", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Get the Unicode code point with the given index.\nIndices start at 0.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("char_at"), nil, []*Parameter{NewParameter(value.ToSymbol("index"), NameToType("Std::AnyInt", env), NormalParameterKind, false)}, NameToType("Std::Char", env), Never{})
 				namespace.DefineMethod("Get the number of Unicode code points\nthat this `String` contains.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("char_count"), nil, nil, NameToType("Std::Int", env), Never{})
 				namespace.DefineMethod("Iterates over all unicode code points of a `String`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("char_iter"), nil, nil, NameToType("Std::String::CharIterator", env), Never{})
+				namespace.DefineMethod("Returns a list of all Unicode code points of the `String`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("chars"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Char", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
 				namespace.DefineMethod("Concatenate this `String`\nwith another `String` or `Char`.\n\nCreates a new `String` containing the content\nof both operands.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("concat"), nil, []*Parameter{NewParameter(value.ToSymbol("other"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Check whether the `String` contains the given\nsubstring, char or a match of the regex.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("contains"), nil, []*Parameter{NewParameter(value.ToSymbol("search"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false)}, Bool{}, Never{})
+				namespace.DefineMethod("Check whether the `String` ends with the given suffix.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("ends_with"), nil, []*Parameter{NewParameter(value.ToSymbol("suffix"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, Bool{}, Never{})
 				namespace.DefineMethod("Get the Unicode grapheme cluster with the given index.\nIndices start at 0.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("grapheme_at"), nil, []*Parameter{NewParameter(value.ToSymbol("index"), NameToType("Std::AnyInt", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Get the number of unicode grapheme clusters\npresent in this string.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("grapheme_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Returns the index of the grapheme cluster in which the first match\nof the given pattern begins.\nThe search starts at the grapheme cluster with index `start`,\nnegative indices are counted from the end of the `String`.\n\nReturns `-1` when the pattern cannot be found.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("grapheme_index_of"), nil, []*Parameter{NewParameter(value.ToSymbol("search"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("start"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, NameToType("Std::Int", env), Never{})
 				namespace.DefineMethod("Iterates over all grapheme clusters of a `String`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("grapheme_iter"), nil, nil, NameToType("Std::String::GraphemeIterator", env), Never{})
+				namespace.DefineMethod("Returns a new string with the grapheme clusters\nthat have indices included in the given range.\n\nNegative indices are counted from the end of the `String`.\nIndices out of bounds are ignored.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("grapheme_slice"), nil, []*Parameter{NewParameter(value.ToSymbol("range"), NewGeneric(NameToType("Std::Range", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Element"): NewTypeArgument(NameToType("Std::Int", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Element")})), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Returns a list of all grapheme clusters of the `String`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("graphemes"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
 				namespace.DefineMethod("Calculates a hash of the string.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("hash"), nil, nil, NameToType("Std::UInt64", env), Never{})
+				namespace.DefineMethod("Returns the index of the char at which the first match\nof the given pattern begins.\nThe search starts at the char with index `start`,\nnegative indices are counted from the end of the `String`.\n\nReturns `-1` when the pattern cannot be found.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("index_of"), nil, []*Parameter{NewParameter(value.ToSymbol("search"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("start"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, NameToType("Std::Int", env), Never{})
 				namespace.DefineMethod("Check whether the `String` is empty.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("is_empty"), nil, nil, Bool{}, Never{})
 				namespace.DefineMethod("Iterates over all unicode code points of a `String`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("iter"), nil, nil, NameToType("Std::String::CharIterator", env), Never{})
+				namespace.DefineMethod("Returns the index of the char at which the last match\nof the given pattern begins.\n\nReturns `-1` when the pattern cannot be found.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("last_index_of"), nil, []*Parameter{NewParameter(value.ToSymbol("search"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
 				namespace.DefineMethod("Get the number of Unicode code points\nthat this `String` contains.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("length"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Split the `String` into lines.\n\nLine terminators (`\\n` and `\\r\\n`) are not included in the result.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("lines"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
 				namespace.DefineMethod("Create a new string left justified\nto the given length using the given char for padding.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("ljust"), nil, []*Parameter{NewParameter(value.ToSymbol("len"), NameToType("Std::Int", env), NormalParameterKind, false), NewParameter(value.ToSymbol("padding"), NameToType("Std::Char", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Create a new string with all of the characters\nof this one turned into lowercase.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("lowercase"), nil, nil, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Remove the given prefix from the `String`.\n\nDoes nothing if the `String` doesn't start\nwith `prefix` and returns `self`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("remove_prefix"), nil, []*Parameter{NewParameter(value.ToSymbol("prefix"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Remove the given suffix from the `String`.\n\nDoes nothing if the `String` doesn't end\nwith `suffix` and returns `self`.\n\nIf the `String` ends with the given suffix\na new `String` gets created and returned that doesn't contain\nthe suffix.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("remove_suffix"), nil, []*Parameter{NewParameter(value.ToSymbol("suffix"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Creates a new `String` that contains the\ncontent of `self` repeated `n` times.", 0|METHOD_SEALED_FLAG|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("repeat"), nil, []*Parameter{NewParameter(value.ToSymbol("n"), NameToType("Std::Int", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Create a new string with the first match\nof the given pattern replaced with `replacement`.\n\nWhen `search` is a `Regex` the replacement\ncan refer to capture groups with `$1` or `${name}`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("replace"), nil, []*Parameter{NewParameter(value.ToSymbol("search"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Create a new string with all matches\nof the given pattern replaced with `replacement`.\n\nWhen `search` is a `Regex` the replacement\ncan refer to capture groups with `$1` or `${name}`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("replace_all"), nil, []*Parameter{NewParameter(value.ToSymbol("search"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("replacement"), NameToType("Std::String", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Create a new string with the chars in reverse order.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("reverse"), nil, nil, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Create a new string with the grapheme clusters in reverse order.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("reverse_graphemes"), nil, nil, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Create a new string right justified\nto the given length using the given char for padding.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("rjust"), nil, []*Parameter{NewParameter(value.ToSymbol("len"), NameToType("Std::Int", env), NormalParameterKind, false), NewParameter(value.ToSymbol("padding"), NameToType("Std::Char", env), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Returns a new string with the chars\nthat have indices included in the given range.\n\nNegative indices are counted from the end of the `String`.\nIndices out of bounds are ignored.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("slice"), nil, []*Parameter{NewParameter(value.ToSymbol("range"), NewGeneric(NameToType("Std::Range", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Element"): NewTypeArgument(NameToType("Std::Int", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Element")})), NormalParameterKind, false)}, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Split the `String` into substrings separated by the given pattern.\n\n`limit` is the maximum number of substrings.\nWhen it's negative all substrings are returned.\n\nAn empty `String` separator splits the `String` into chars.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("split"), nil, []*Parameter{NewParameter(value.ToSymbol("separator"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env), NameToType("Std::Regex", env)), NormalParameterKind, false), NewParameter(value.ToSymbol("limit"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::String", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+				namespace.DefineMethod("Check whether the `String` starts with the given prefix.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("starts_with"), nil, []*Parameter{NewParameter(value.ToSymbol("prefix"), NewUnion(NameToType("Std::String", env), NameToType("Std::Char", env)), NormalParameterKind, false)}, Bool{}, Never{})
+				namespace.DefineMethod("Create a new string with leading and trailing\nwhitespace removed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("strip"), nil, nil, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Returns the constant AST Node with the same value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_ast_const_node"), nil, nil, NameToType("Std::Elk::AST::PublicConstantNode", env), Never{})
 				namespace.DefineMethod("Returns the AST Node that represents the same value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_ast_expr_node"), nil, nil, NameToType("Std::Elk::AST::DoubleQuotedStringLiteralNode", env), Never{})
 				namespace.DefineMethod("Returns the identifier AST Node with the same value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_ast_ident_node"), nil, nil, NameToType("Std::Elk::AST::PublicIdentifierNode", env), Never{})
@@ -9757,12 +10639,29 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				namespace.DefineMethod("Convert the `String` to an `Int` interpreting\nthe chars according to the given `base`.\n\nIf no `base` is given `10` is assumed.\nWhen the string contains one of the predefined prefixes\nit will be used to infer the base:\n- `0x` - hexadecimal\n- `0d` - base 12\n- `0o` - base 8\n- `0b` - base 2", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_int"), nil, []*Parameter{NewParameter(value.ToSymbol("base"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, NameToType("Std::Int", env), NameToType("Std::FormatError", env))
 				namespace.DefineMethod("Returns itself.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("to_string"), nil, nil, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Convert the `String` to a `Symbol`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("to_symbol"), nil, nil, NameToType("Std::Symbol", env), Never{})
+				namespace.DefineMethod("Create a new string with leading and trailing\nwhitespace removed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("trim"), nil, nil, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Create a new string with trailing whitespace removed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("trim_end"), nil, nil, NameToType("Std::String", env), Never{})
+				namespace.DefineMethod("Create a new string with leading whitespace removed.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("trim_start"), nil, nil, NameToType("Std::String", env), Never{})
 				namespace.DefineMethod("Create a new string with all of the characters\nof this one turned into uppercase.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("uppercase"), nil, nil, NameToType("Std::String", env), Never{})
 
 				// Define constants
 
 				// Define instance variables
 
+				{
+					namespace := namespace.Singleton()
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Create a new `String` by formatting the arguments\naccording to the format string.\n\nFormat specifiers have the form `%[flags][width][.precision]verb`.\n\nVerbs:\n- `%s` the result of `to_string`\n- `%p` the result of `inspect`\n- `%d`, `%i` a decimal integer\n- `%x`, `%X` a hexadecimal integer\n- `%o` an octal integer\n- `%b` a binary integer\n- `%f`, `%F` a decimal number\n- `%e`, `%E` a number in scientific notation\n- `%g`, `%G` a number in the shortest of `%f` and `%e`\n- `%c` a character\n- `%%` a literal percent sign\n\nFlags:\n- `-` left justify\n- `+` always print the sign of numbers\n- ` ` leave a space for the sign of positive numbers\n- `0` pad numbers with leading zeros\n- `#` add a radix prefix like `0x`, `0o` or `0b`\n\nThe format string and arguments are verified\nby the type checker when the format string is a literal.\nOtherwise throws an unchecked `FormatError` for invalid specifiers\nor a wrong number of arguments\nand `TypeError` when an argument has the wrong type.\n\n\tString.format('%-6s|%08.3f|%#x', \"foo\", 3.14159, 255)\n\t#=> \"foo   |0003.142|0xff\"", 0|METHOD_NATIVE_FLAG, value.ToSymbol("format"), nil, []*Parameter{NewParameter(value.ToSymbol("format"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("args"), Any{}, PositionalRestParameterKind, false)}, NameToType("Std::String", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
 				{
 					namespace := namespace.MustSubtypeString("ByteIterator").(*Class)
 
@@ -9907,6 +10806,145 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 				// Define instance variables
 
+				{
+					namespace := namespace.MustSubtypeString("AtomicBool").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new atomic boolean with the given value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), Bool{}, DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Sets a new value only when the current value is equal to `expected`.\nReturns `true` when the value has been set.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("compare_and_swap"), nil, []*Parameter{NewParameter(value.ToSymbol("expected"), Bool{}, NormalParameterKind, false), NewParameter(value.ToSymbol("value"), Bool{}, NormalParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Returns the current value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("load"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Sets a new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("store"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), Bool{}, NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Sets a new value and returns the old one.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("swap"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), Bool{}, NormalParameterKind, false)}, Bool{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("AtomicInt").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new atomic integer with the given value.\nThrows an unchecked `OutOfRangeError` when the value does not fit in 64 bits.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Int", env), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Adds `delta` to the value and returns the new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("add"), nil, []*Parameter{NewParameter(value.ToSymbol("delta"), NameToType("Std::Int", env), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Sets a new value only when the current value is equal to `expected`.\nReturns `true` when the value has been set.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("compare_and_swap"), nil, []*Parameter{NewParameter(value.ToSymbol("expected"), NameToType("Std::Int", env), NormalParameterKind, false), NewParameter(value.ToSymbol("value"), NameToType("Std::Int", env), NormalParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Returns the current value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("load"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Sets a new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("store"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Int", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Subtracts `delta` from the value and returns the new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("sub"), nil, []*Parameter{NewParameter(value.ToSymbol("delta"), NameToType("Std::Int", env), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Sets a new value and returns the old one.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("swap"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Int", env), NormalParameterKind, false)}, NameToType("Std::Int", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("AtomicRef").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Set up type parameters
+					var typeParam *TypeParameter
+					typeParams := make([]*TypeParameter, 1)
+
+					typeParam = NewTypeParameter(value.ToSymbol("T"), namespace, Never{}, Any{}, nil, INVARIANT)
+					typeParams[0] = typeParam
+					namespace.DefineSubtype(value.ToSymbol("T"), typeParam)
+					namespace.DefineConstant(value.ToSymbol("T"), NoValue{})
+
+					namespace.SetTypeParameters(typeParams)
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new atomic reference to the given value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::AtomicRef::T", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Sets a new value only when the current value is identical to `expected`.\nReturns `true` when the value has been set.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("compare_and_swap"), nil, []*Parameter{NewParameter(value.ToSymbol("expected"), NameToType("Std::Sync::AtomicRef::T", env), NormalParameterKind, false), NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::AtomicRef::T", env), NormalParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Returns the current value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("load"), nil, nil, NameToType("Std::Sync::AtomicRef::T", env), Never{})
+					namespace.DefineMethod("Sets a new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("store"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::AtomicRef::T", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Sets a new value and returns the old one.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("swap"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::AtomicRef::T", env), NormalParameterKind, false)}, NameToType("Std::Sync::AtomicRef::T", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("AtomicUInt64").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new atomic unsigned integer with the given value.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::UInt64", env), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Adds `delta` to the value and returns the new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("add"), nil, []*Parameter{NewParameter(value.ToSymbol("delta"), NameToType("Std::UInt64", env), NormalParameterKind, false)}, NameToType("Std::UInt64", env), Never{})
+					namespace.DefineMethod("Sets a new value only when the current value is equal to `expected`.\nReturns `true` when the value has been set.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("compare_and_swap"), nil, []*Parameter{NewParameter(value.ToSymbol("expected"), NameToType("Std::UInt64", env), NormalParameterKind, false), NewParameter(value.ToSymbol("value"), NameToType("Std::UInt64", env), NormalParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Returns the current value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("load"), nil, nil, NameToType("Std::UInt64", env), Never{})
+					namespace.DefineMethod("Sets a new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("store"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::UInt64", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Subtracts `delta` from the value and returns the new value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("sub"), nil, []*Parameter{NewParameter(value.ToSymbol("delta"), NameToType("Std::UInt64", env), NormalParameterKind, false)}, NameToType("Std::UInt64", env), Never{})
+					namespace.DefineMethod("Sets a new value and returns the old one.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("swap"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::UInt64", env), NormalParameterKind, false)}, NameToType("Std::UInt64", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Barrier").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new barrier for the given number of parties.\nThrows an unchecked `OutOfRangeError` when `parties` is not positive.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("parties"), NameToType("Std::Int", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the number of threads required to trip the barrier.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("parties"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Blocks the current thread until all parties are waiting on the barrier.\nReturns `true` in the thread that arrived last and tripped the barrier.\n\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting,\nthe thread then stops being counted as waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), nil, []*Parameter{NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Returns the number of threads currently waiting on the barrier.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("waiting_count"), nil, nil, NameToType("Std::Int", env), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Cond").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new condition variable tied to the given mutex.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("mutex"), NameToType("Std::Sync::Mutex", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Wakes up all threads waiting on the condition variable.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("broadcast"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Returns the mutex of the condition variable.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("mutex"), nil, nil, NameToType("Std::Sync::Mutex", env), Never{})
+					namespace.DefineMethod("Wakes up one thread waiting on the condition variable.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("signal"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Unlocks the mutex and blocks the current thread\nuntil it gets woken up by `signal` or `broadcast`.\nThe mutex gets locked again before `wait` returns.\n\nThe mutex has to be locked by the caller.\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), nil, []*Parameter{NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("CountDownLatch").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new latch with the given counter.\nThrows an unchecked `OutOfRangeError` when `count` is negative.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("count"), NameToType("Std::Int", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the current value of the counter.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("count"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Decrements the counter.\nReleases all waiting threads when it reaches zero.\nDoes nothing when the counter is already zero.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("count_down"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Blocks the current thread until the counter reaches zero.\n\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), nil, []*Parameter{NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
 				{
 					namespace := namespace.MustSubtypeString("DiagnosticList").(*Class)
 
@@ -9965,6 +11003,46 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 						// Define instance variables
 					}
 				}
+				{
+					namespace := namespace.MustSubtypeString("Map").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Set up type parameters
+					var typeParam *TypeParameter
+					typeParams := make([]*TypeParameter, 2)
+
+					typeParam = NewTypeParameter(value.ToSymbol("Key"), namespace, Never{}, Any{}, nil, INVARIANT)
+					typeParams[0] = typeParam
+					namespace.DefineSubtype(value.ToSymbol("Key"), typeParam)
+					namespace.DefineConstant(value.ToSymbol("Key"), NoValue{})
+
+					typeParam = NewTypeParameter(value.ToSymbol("Value"), namespace, Never{}, Any{}, nil, INVARIANT)
+					typeParams[1] = typeParam
+					namespace.DefineSubtype(value.ToSymbol("Value"), typeParam)
+					namespace.DefineConstant(value.ToSymbol("Value"), NoValue{})
+
+					namespace.SetTypeParameters(typeParams)
+
+					// Include mixins and implement interfaces
+					IncludeMixin(namespace, NewGeneric(NameToType("Std::Iterable::FiniteBase", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewGeneric(NameToType("Std::Pair", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::Sync::Map::Key", env), COVARIANT), value.ToSymbol("Value"): NewTypeArgument(NameToType("Std::Sync::Map::Value", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")})), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})))
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new empty map.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Get the element under the given key.\nReturns `nil` when the key is not present.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("[]"), nil, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false)}, NewNilable(NameToType("Std::Sync::Map::Value", env)), Never{})
+					namespace.DefineMethod("Set the element under the given key to the given value.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("[]="), nil, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false), NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::Map::Value", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Atomically replaces the element under the given key\nwith the result of `fn`.\n\n`fn` receives the current element or `nil` when the key is not present.\nThe key gets removed when `fn` returns `nil`.\n\nWhen another thread modifies the key before the result is stored\n`fn` gets called again with the new element,\nso it may be called more than once.\n\nReturns the new element.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("compute"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :compute", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false), NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NewNilable(NameToType("Std::Sync::Map::Value", env)), NormalParameterKind, false)}, NewNilable(NameToType("Std::Sync::Map::Value", env)), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :compute", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewNilable(NameToType("Std::Sync::Map::Value", env)), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :compute", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Returns `true` when the key is present in the map.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("contains_key"), nil, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Returns the element under the given key.\n\nWhen the key is not present `fn` gets called\nand its result is inserted under the key.\n`fn` is called only once per key,\nother threads calling `get_or_insert` with the same key\nwait for it to return and get its result.\nWhen it throws, the next waiting thread calls its own `fn`.\n\nWhen the key gets set with `[]=` while `fn` is running\nthat element is returned and the result of `fn` is discarded.\nCalling `get_or_insert` with the same key inside `fn`\nthrows an `ArgumentError`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("get_or_insert"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :get_or_insert", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false), NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false)}, NameToType("Std::Sync::Map::Value", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :get_or_insert", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NameToType("Std::Sync::Map::Value", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :get_or_insert", true), Never{}, Any{}, Never{}, INVARIANT))
+					namespace.DefineMethod("Returns an iterator over a snapshot of the pairs.\n\nThe map may be freely modified during iteration,\nchanges may or may not be visible to the iterator.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("iter"), nil, nil, NewGeneric(NameToType("Std::ArrayList::Iterator", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewGeneric(NameToType("Std::Pair", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Key"): NewTypeArgument(NameToType("Std::Sync::Map::Key", env), COVARIANT), value.ToSymbol("Value"): NewTypeArgument(NameToType("Std::Sync::Map::Value", env), COVARIANT)}, []value.Symbol{value.ToSymbol("Key"), value.ToSymbol("Value")})), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Returns the number of key-value pairs present in the map.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("length"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Removes the given key from the map.\nReturns its previous value or `nil` when the key was not present.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("remove"), nil, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false)}, NewNilable(NameToType("Std::Sync::Map::Value", env)), Never{})
+					namespace.DefineMethod("Removes all pairs for which `fn` returns a truthy value.\nPairs modified by other threads after `fn` has been called on them are kept.\n\nReturns the number of removed pairs.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("remove_if"), []*TypeParameter{NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :remove_if", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("key"), NameToType("Std::Sync::Map::Key", env), NormalParameterKind, false), NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::Map::Value", env), NormalParameterKind, false)}, Bool{}, NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :remove_if", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NameToType("Std::Int", env), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :remove_if", true), Never{}, Any{}, Never{}, INVARIANT))
+
+					// Define constants
+
+					// Define instance variables
+				}
 				{
 					namespace := namespace.MustSubtypeString("Mutex").(*Class)
 
@@ -10010,6 +11088,39 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 						// Define instance variables
 					}
 				}
+				{
+					namespace := namespace.MustSubtypeString("Queue").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Set up type parameters
+					var typeParam *TypeParameter
+					typeParams := make([]*TypeParameter, 1)
+
+					typeParam = NewTypeParameter(value.ToSymbol("V"), namespace, Never{}, Any{}, nil, INVARIANT)
+					typeParams[0] = typeParam
+					namespace.DefineSubtype(value.ToSymbol("V"), typeParam)
+					namespace.DefineConstant(value.ToSymbol("V"), NoValue{})
+
+					namespace.SetTypeParameters(typeParams)
+
+					// Include mixins and implement interfaces
+					IncludeMixin(namespace, NewGeneric(NameToType("Std::Iterable::FiniteBase", env).(*Mixin), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Sync::Queue::V", env), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Never{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})))
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new empty queue.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Appends the value to the end of the queue.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("<<"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::Queue::V", env), NormalParameterKind, false)}, Self{}, Never{})
+					namespace.DefineMethod("Returns `true` when the queue is empty.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_empty"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Returns an iterator over a snapshot of the elements.\n\nThe queue may be freely modified during iteration,\nchanges are not visible to the iterator.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("iter"), nil, nil, NewGeneric(NameToType("Std::ArrayList::Iterator", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NameToType("Std::Sync::Queue::V", env), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+					namespace.DefineMethod("Returns the number of elements in the queue.\nThe result may already be stale when other threads\nmodify the queue.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("length"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Removes and returns the first element of the queue.\nBlocks the current thread until an element is available.\n\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("pop"), nil, []*Parameter{NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, NameToType("Std::Sync::Queue::V", env), Never{})
+					namespace.DefineMethod("Appends the value to the end of the queue.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("push"), nil, []*Parameter{NewParameter(value.ToSymbol("value"), NameToType("Std::Sync::Queue::V", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Removes and returns the first element of the queue.\nReturns `nil` when the queue is empty.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("try_pop"), nil, nil, NewNilable(NameToType("Std::Sync::Queue::V", env)), Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
 				{
 					namespace := namespace.MustSubtypeString("ROMutex").(*Class)
 
@@ -10045,6 +11156,99 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 
 					// Define instance variables
 				}
+				{
+					namespace := namespace.MustSubtypeString("Semaphore").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new semaphore with the given number of permits.\nThrows an unchecked `OutOfRangeError` when `permits` is not positive.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("permits"), NameToType("Std::Int", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Acquires a permit.\nBlocks the current thread until a permit is available.\n\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("acquire"), nil, []*Parameter{NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the number of permits that can be acquired without blocking.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("available_permits"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Returns the total number of permits.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("permits"), nil, nil, NameToType("Std::Int", env), Never{})
+					namespace.DefineMethod("Releases an acquired permit.\nThrows an unchecked `ReleaseError` when no permits have been acquired.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("release"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Tries to acquire a permit.\nReturns `false` when no permit could be acquired.\n\nReturns immediately when no timeout is given,\notherwise blocks the current thread for at most `timeout`.\n\nThrows an unchecked error when the aborter of the current thread\nor the given aborter gets closed while waiting.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("try_acquire"), nil, []*Parameter{NewParameter(value.ToSymbol("timeout"), NewNilable(NameToType("Std::Time::Span", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("aborter"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Bool{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+
+					{
+						namespace := namespace.MustSubtypeString("ReleaseError").(*Class)
+
+						namespace.Name() // noop - avoid unused variable error
+						namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+						// Include mixins and implement interfaces
+
+						// Define methods
+
+						// Define constants
+
+						// Define instance variables
+					}
+				}
+				{
+					namespace := namespace.MustSubtypeString("TaskGroup").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new task group.\nThe group gets cancelled when the parent aborter is closed,\nby default it is the aborter of the current thread.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("parent"), NewNilable(NameToType("Std::Aborter", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Adds an existing promise or a thread started with `go`\nto the group.\nIt gets cancelled when the group is cancelled.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("<<"), nil, []*Parameter{NewParameter(value.ToSymbol("child"), NewUnion(NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), NameToType("Std::Thread", env)), NormalParameterKind, false)}, Self{}, Never{})
+					namespace.DefineMethod("Returns the aborter shared by all children of the group.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("aborter"), nil, nil, NameToType("Std::Aborter", env), Never{})
+					namespace.DefineMethod("Adds an existing promise or a thread started with `go`\nto the group.\nIt gets cancelled when the group is cancelled.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("add"), nil, []*Parameter{NewParameter(value.ToSymbol("child"), NewUnion(NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, COVARIANT), value.ToSymbol("Err"): NewTypeArgument(Any{}, COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), NameToType("Std::Thread", env)), NormalParameterKind, false)}, Self{}, Never{})
+					namespace.DefineMethod("Cancels all children of the group.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("cancel"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Whether the group has been cancelled.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_cancelled"), nil, nil, Bool{}, Never{})
+					namespace.DefineMethod("Executes the given function in the thread pool of the current thread\nas a child of the group.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("spawn"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, nil, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, Never{}, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
+					namespace.DefineMethod("Executes the given function in a new thread\nas a child of the group.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("spawn_thread"), nil, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, nil, Any{}, Never{}, false), NormalParameterKind, false)}, NameToType("Std::Thread", env), Never{})
+					namespace.DefineMethod("Blocks the current thread until all children of the group are done.\nThrows an unchecked `TaskGroup::Error` when children of the group have failed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wait"), nil, nil, Void{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+
+					{
+						namespace := namespace.Singleton()
+
+						namespace.Name() // noop - avoid unused variable error
+
+						// Include mixins and implement interfaces
+
+						// Define methods
+						namespace.DefineMethod("Creates a new task group and calls the given function with it.\nWaits for all children of the group before returning\nthe value returned by the function.\n\nWhen the function throws an error, the group gets cancelled\nand the error gets rethrown after all children are done.\nThrows an unchecked `TaskGroup::Error` when children of the group have failed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("run"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :run", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :run", true), Never{}, Any{}, Never{}, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("group"), NameToType("Std::Sync::TaskGroup", env), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :run", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :run", true), Never{}, Any{}, Never{}, INVARIANT), false), NormalParameterKind, false)}, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :run", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :run", true), Never{}, Any{}, Never{}, INVARIANT))
+
+						// Define constants
+
+						// Define instance variables
+					}
+					{
+						namespace := namespace.MustSubtypeString("Error").(*Class)
+
+						namespace.Name() // noop - avoid unused variable error
+						namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+						// Include mixins and implement interfaces
+
+						// Define methods
+						method = namespace.DefineMethod("", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("message"), NameToType("Std::String", env), NormalParameterKind, false), NewParameter(value.ToSymbol("errors"), NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NormalParameterKind, false), NewParameter(value.ToSymbol("stack_traces"), NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewNilable(NameToType("Std::StackTrace", env)), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), NormalParameterKind, false)}, Void{}, Never{})
+						ivars := method.InitialisedInstanceVariables
+						ivars.Add(value.ToSymbol("stack_traces"))
+						ivars.Add(value.ToSymbol("errors"))
+						namespace.DefineMethod("Returns the errors of the failed children.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("errors"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+						namespace.DefineMethod("Returns the stack traces of the errors of the failed children,\nin the same order as `errors`.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("stack_traces"), nil, nil, NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewNilable(NameToType("Std::StackTrace", env)), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), Never{})
+
+						// Define constants
+
+						// Define instance variables
+						namespace.DefineInstanceVariable(value.ToSymbol("errors"), NewInstanceVariable(value.ToSymbol("errors"), NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(Any{}, INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), "", false))
+						namespace.DefineInstanceVariable(value.ToSymbol("stack_traces"), NewInstanceVariable(value.ToSymbol("stack_traces"), NewGeneric(NameToType("Std::ArrayList", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewNilable(NameToType("Std::StackTrace", env)), INVARIANT)}, []value.Symbol{value.ToSymbol("Val")})), "", false))
+					}
+				}
 				{
 					namespace := namespace.MustSubtypeString("WaitGroup").(*Class)
 
@@ -10073,11 +11277,66 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				// Include mixins and implement interfaces
 
 				// Define methods
+				namespace.DefineMethod("Returns the aborter of the thread.\nIt gets closed when the thread is cancelled.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("aborter"), nil, nil, NameToType("Std::Aborter", env), Never{})
+				namespace.DefineMethod("Cancels the thread by closing its aborter.\n\nA cancelled thread stops at the next safe point\n(a loop iteration or a blocking operation)\nwith an `execution aborted` error.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("cancel"), nil, nil, Void{}, Never{})
+				namespace.DefineMethod("Returns the uncaught error that terminated the thread\nor `nil` when the thread has not failed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("error"), nil, nil, NameToType("Std::Value", env), Never{})
+				namespace.DefineMethod("Returns the number of bytecode instructions\nexecuted by the thread so far.\nThe count is updated at safe points and when the thread stops running.\n\nInstructions are only counted when the `ELK_COUNT_INSTRUCTIONS`\nenvironment variable is set to `1`, otherwise it returns `0`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("instruction_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Returns `true` when the thread started with `go` has finished.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_done"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Waits for the thread started with `go` to finish\nand returns the value returned by it.\n\nThrows an unchecked `Thread::TimeoutError` when the thread does not finish\nbefore the given timeout.\nThrows an unchecked `Thread::Error` when the thread terminated with an uncaught error,\nthe error can be retrieved with `error`.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("join"), nil, []*Parameter{NewParameter(value.ToSymbol("timeout"), NewNilable(NameToType("Std::Time::Span", env)), DefaultValueParameterKind, false)}, Any{}, Never{})
+				namespace.DefineMethod("Returns the name of the thread.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("name"), nil, nil, NewNilable(NameToType("Std::String", env)), Never{})
+				namespace.DefineMethod("Sets the name of the thread.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("name="), nil, []*Parameter{NewParameter(value.ToSymbol("name"), NameToType("Std::String", env), NormalParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Returns the stack trace of the uncaught error that terminated the thread\nor `nil` when the thread has not failed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stack_trace"), nil, nil, NewNilable(NameToType("Std::StackTrace", env)), Never{})
 				namespace.DefineMethod("Returns the current state of the thread.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("state"), nil, nil, NameToType("Std::Symbol", env), Never{})
+				namespace.DefineMethod("Returns the total amount of wall-clock time the thread\nspent executing code, including the time it was blocked.\nIt is not the CPU time of the thread.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("wall_time"), nil, nil, NameToType("Std::Time::Span", env), Never{})
 
 				// Define constants
 
 				// Define instance variables
+
+				{
+					namespace := namespace.Singleton()
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					namespace.DefineMethod("Removes the handler set with `on_uncaught_error`\nand restores the default behaviour of printing\nuncaught errors to stderr.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("clear_uncaught_error_handler"), nil, nil, Void{}, Never{})
+					namespace.DefineMethod("Returns the thread that executes the current code.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("current"), nil, nil, NameToType("Std::Thread", env), Never{})
+					namespace.DefineMethod("Sets the handler that gets called when a thread started with `go`\nterminates with an uncaught error.\nBy default such errors get printed to stderr.\n\nThe handler runs before the failed thread is marked as done,\nso threads joining it see the error only after it has been handled.\nJoining the failed thread in the handler never returns.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("on_uncaught_error"), nil, []*Parameter{NewParameter(value.ToSymbol("handler"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, []*Parameter{NewParameter(value.ToSymbol("thread"), NameToType("Std::Thread", env), NormalParameterKind, false), NewParameter(value.ToSymbol("err"), NameToType("Std::Value", env), NormalParameterKind, false)}, Void{}, Never{}, false), NormalParameterKind, false)}, Void{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Error").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("TimeoutError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Thread::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
 			}
 			{
 				namespace := namespace.MustSubtypeString("ThreadPool").(*Class)
@@ -10085,15 +11344,55 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 				namespace.Name() // noop - avoid unused variable error
 
 				// Include mixins and implement interfaces
+				ImplementInterface(namespace, NameToType("Std::Closable", env).(*Interface))
 
 				// Define methods
-				namespace.DefineMethod("Closes the thread pool, shuts down all thread workers.\n\nA thread pool has to be closed when its no longer needed\notherwise it will never get garbage collected and the threads\nwill keep on waiting for work indefinitely.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("close"), nil, nil, Void{}, Never{})
+				method = namespace.DefineMethod("Create a new thread pool with the given number of thread workers\nand the given size of the task queue.\n\nDefaults are configured with the `ELK_DEFAULT_THREAD_POOL_SIZE`\nand `ELK_DEFAULT_THREAD_POOL_QUEUE_SIZE` environment variables.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("threads"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false), NewParameter(value.ToSymbol("queue_size"), NewNilable(NameToType("Std::Int", env)), DefaultValueParameterKind, false)}, Void{}, Never{})
+				namespace.DefineMethod("Closes the thread pool gracefully.\nNew tasks are no longer accepted,\nthread workers shut down after all pending tasks have been completed.\nUse `drain` to wait for them.\n\nA thread pool has to be closed when its no longer needed\notherwise it will never get garbage collected and the threads\nwill keep on waiting for work indefinitely.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("close"), nil, nil, Void{}, Never{})
+				namespace.DefineMethod("Returns the number of tasks executed by the pool\nthat have been resolved or rejected.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("completed_task_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Blocks the current thread until all tasks\nadded to the pool have been completed.\n\nThrows an unchecked `ThreadPool::Error` when called\nby a thread worker of the pool.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("drain"), nil, nil, Void{}, Never{})
+				namespace.DefineMethod("Whether the pool has been closed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("is_closed"), nil, nil, Bool{}, Never{})
+				namespace.DefineMethod("Returns the number of tasks waiting in the queue.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("queued_task_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Returns the number of tasks that are currently\nbeing executed by thread workers.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("running_task_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Execute the given function in a thread worker of the pool.\nReturns a promise that gets resolved with its result.\n\nThe function cannot capture local variables\nthat are still in use by the current thread,\notherwise the promise gets rejected with `OpenClosureError`.\n\nThe promise gets rejected with `ThreadPool::ClosedError`\nwhen the pool has been closed.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("spawn"), []*TypeParameter{NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT)}, []*Parameter{NewParameter(value.ToSymbol("fn"), NewCallableWithMethod("", 0|METHOD_NATIVE_FLAG, value.ToSymbol("call"), nil, nil, NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), false), NormalParameterKind, false)}, NewGeneric(NameToType("Std::Promise", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("Val"): NewTypeArgument(NewTypeParameter(value.ToSymbol("V"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), COVARIANT), value.ToSymbol("Err"): NewTypeArgument(NewTypeParameter(value.ToSymbol("E"), NewTypeParamNamespace("Type Parameter Container of :spawn", true), Never{}, Any{}, nil, INVARIANT), COVARIANT)}, []value.Symbol{value.ToSymbol("Val"), value.ToSymbol("Err")})), Never{})
 				namespace.DefineMethod("Returns the number of available slots in the task\nqueue.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("task_queue_size"), nil, nil, NameToType("Std::Int", env), Never{})
 				namespace.DefineMethod("Returns the count of thread workers available in the pool.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("thread_count"), nil, nil, NameToType("Std::Int", env), Never{})
+				namespace.DefineMethod("Returns the time slice of promises executed by the pool.\n\nA promise that runs longer than its time slice\ngets preempted at the next safe point (a loop iteration in its body)\nwhen there are other tasks waiting in the queue.\nIt gets put at the end of the queue and resumed later.\n\nLoops in functions and methods called by the promise\nare not safe points, so a promise stuck in such a loop\nkeeps its thread worker until the call returns.\n\nZero means that promises are never preempted.\nPreemption is disabled by default,\nit can be enabled with the `ELK_TIME_SLICE` environment variable\n(in milliseconds).", 0|METHOD_NATIVE_FLAG, value.ToSymbol("time_slice"), nil, nil, NameToType("Std::Time::Span", env), Never{})
+				namespace.DefineMethod("Sets the time slice of promises executed by the pool.\nZero disables preemption.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("time_slice="), nil, []*Parameter{NewParameter(value.ToSymbol("time_slice"), NameToType("Std::Time::Span", env), NormalParameterKind, false)}, Void{}, Never{})
 
 				// Define constants
+				namespace.DefineConstant(value.ToSymbol("DEFAULT"), NameToType("Std::ThreadPool", env))
 
 				// Define instance variables
+
+				{
+					namespace := namespace.MustSubtypeString("ClosedError").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::ThreadPool::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Error").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+					namespace.SetParent(NameToType("Std::Error", env).(*Class))
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+
+					// Define constants
+
+					// Define instance variables
+				}
 			}
 			{
 				namespace := namespace.MustSubtypeString("Time").(*Class)
@@ -10257,6 +11556,40 @@ func setupGlobalEnvironmentFromHeaders(env *GlobalEnvironment) {
 						// Define instance variables
 					}
 				}
+				{
+					namespace := namespace.MustSubtypeString("Ticker").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new ticker that fires every time the given time span elapses.\nThrows an unchecked `OutOfRangeError` when the span is not positive.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("span"), NameToType("Std::Time::Span", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the channel that receives the datetime on every tick.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("channel"), nil, nil, NewGeneric(NameToType("Std::ReadChannel", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("V"): NewTypeArgument(NameToType("Std::DateTime", env), INVARIANT)}, []value.Symbol{value.ToSymbol("V")})), Never{})
+					namespace.DefineMethod("Stops the ticker and resets its interval to the given time span.\nThrows an unchecked `OutOfRangeError` when the span is not positive.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("reset"), nil, []*Parameter{NewParameter(value.ToSymbol("span"), NameToType("Std::Time::Span", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Stops the ticker.\nNo more ticks will be sent after the ticker is stopped.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stop"), nil, nil, Void{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
+				{
+					namespace := namespace.MustSubtypeString("Timer").(*Class)
+
+					namespace.Name() // noop - avoid unused variable error
+
+					// Include mixins and implement interfaces
+
+					// Define methods
+					method = namespace.DefineMethod("Creates a new timer that fires after the given time span.", 0|METHOD_NATIVE_FLAG|METHOD_PURE_FLAG, value.ToSymbol("#init"), nil, []*Parameter{NewParameter(value.ToSymbol("span"), NameToType("Std::Time::Span", env), NormalParameterKind, false)}, Void{}, Never{})
+					namespace.DefineMethod("Returns the channel that receives the datetime\nwhen the timer fires.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("channel"), nil, nil, NewGeneric(NameToType("Std::ReadChannel", env).(*Class), NewTypeArguments(TypeArgumentMap{value.ToSymbol("V"): NewTypeArgument(NameToType("Std::DateTime", env), INVARIANT)}, []value.Symbol{value.ToSymbol("V")})), Never{})
+					namespace.DefineMethod("Changes the timer to fire after the given time span\ncounting from now.\nReturns `false` when the timer has already fired or been stopped.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("reset"), nil, []*Parameter{NewParameter(value.ToSymbol("span"), NameToType("Std::Time::Span", env), NormalParameterKind, false)}, Bool{}, Never{})
+					namespace.DefineMethod("Stops the timer so that it never fires.\nReturns `false` when the timer has already fired or been stopped.", 0|METHOD_NATIVE_FLAG, value.ToSymbol("stop"), nil, nil, Bool{}, Never{})
+
+					// Define constants
+
+					// Define instance variables
+				}
 			}
 			{
 				namespace := namespace.MustSubtypeString("Timezone").(*Class)
@@ -11,8 +11,9 @@ var AborterClass *Class                    // ::Std::Aborter
 var AborterCannotBeClosedErrorClass *Class // ::Std::Aborter::CannotBeClosedError
 
 type Aborter struct {
-	ctx    context.Context
-	cancel context.CancelFunc
+	ctx     context.Context
+	cancel  context.CancelFunc
+	release func() bool // detaches the aborter from its parent, nil when it cannot be released
 }
 
 var _ Reference = &Aborter{}
@@ -35,6 +36,19 @@ func NewCancelAborter(parent *Aborter) *Aborter {
 	return NewAborter(context.WithCancel(parent.ctx))
 }
 
+// Create a new closable aborter that gets closed with its parent.
+// Unlike `NewCancelAborter` it can be detached from its parent with `Release`,
+// so that short-lived aborters do not stay registered in long-lived parents.
+func NewReleasableAborter(parent *Aborter) *Aborter {
+	ctx, cancel := context.WithCancel(context.Background())
+	stop := context.AfterFunc(parent.ctx, cancel)
+	return &Aborter{
+		ctx:     ctx,
+		cancel:  cancel,
+		release: stop,
+	}
+}
+
 func NewAborter(ctx context.Context, cancel context.CancelFunc) *Aborter {
 	return &Aborter{
 		ctx:    ctx,
@@ -86,6 +100,15 @@ func (a *Aborter) Close() (err Value) {
 	return Undefined
 }
 
+// Detach a releasable aborter from its parent.
+// The aborter stays open, but it no longer gets closed with its parent.
+// Does nothing for other aborters.
+func (a *Aborter) Release() {
+	if a.release != nil {
+		a.release()
+	}
+}
+
 func (a *Aborter) Closed() *NativeTransformerReadChannel[struct{}] {
 	return NewNativeTransformerReadChannel(
 		a.ctx.Done(),
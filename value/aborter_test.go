@@ -0,0 +1,30 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/elk-language/elk/value"
+)
+
+func TestReleasableAborter(t *testing.T) {
+	parent := value.NewCancelAborter(value.GLOBAL_ABORTER)
+	child := value.NewReleasableAborter(parent)
+	released := value.NewReleasableAborter(parent)
+
+	released.Release()
+	parent.Close()
+	<-child.Context().Done()
+
+	if !child.IsClosed() {
+		t.Fatal("the child aborter should be closed with its parent")
+	}
+	if released.IsClosed() {
+		t.Fatal("a released aborter should not be closed with its parent")
+	}
+	if err := released.Close(); !err.IsUndefined() {
+		t.Fatalf("a released aborter should be closable, got: %s", err.Inspect())
+	}
+	if !released.IsClosed() {
+		t.Fatal("the released aborter should be closed")
+	}
+}
@@ -0,0 +1,24 @@
+package value
+
+var ActorClass *Class                 // ::Std::Actor
+var ActorStoppedErrorClass *Class     // ::Std::Actor::StoppedError
+var ActorMailboxFullErrorClass *Class // ::Std::Actor::MailboxFullError
+var ActorSupervisorClass *Class       // ::Std::Actor::Supervisor
+
+func initActor() {
+	ActorClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	StdModule.AddConstantString("Actor", Ref(ActorClass))
+	RegisterNativeClass("Std::Actor", "value.ActorClass")
+
+	ActorStoppedErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	ActorClass.AddConstantString("StoppedError", Ref(ActorStoppedErrorClass))
+	RegisterNativeClass("Std::Actor::StoppedError", "value.ActorStoppedErrorClass")
+
+	ActorMailboxFullErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	ActorClass.AddConstantString("MailboxFullError", Ref(ActorMailboxFullErrorClass))
+	RegisterNativeClass("Std::Actor::MailboxFullError", "value.ActorMailboxFullErrorClass")
+
+	ActorSupervisorClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	ActorClass.AddConstantString("Supervisor", Ref(ActorSupervisorClass))
+	RegisterNativeClass("Std::Actor::Supervisor", "value.ActorSupervisorClass")
+}
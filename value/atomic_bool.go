@@ -0,0 +1,75 @@
+package value
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+var AtomicBoolClass *Class // ::Std::Sync::AtomicBool
+
+// A boolean that can be safely read and modified by multiple threads.
+type AtomicBool struct {
+	native atomic.Bool
+}
+
+func NewAtomicBool(val bool) *AtomicBool {
+	a := &AtomicBool{}
+	a.native.Store(val)
+	return a
+}
+
+func (a *AtomicBool) Copy() Reference {
+	return a
+}
+
+func (a *AtomicBool) ToValue() Value {
+	return Ref(a)
+}
+
+func (*AtomicBool) Class() *Class {
+	return AtomicBoolClass
+}
+
+func (*AtomicBool) DirectClass() *Class {
+	return AtomicBoolClass
+}
+
+func (*AtomicBool) SingletonClass() *Class {
+	return nil
+}
+
+func (a *AtomicBool) Inspect() string {
+	return fmt.Sprintf("Std::Sync::AtomicBool{&: %p, value: %t}", a, a.Load())
+}
+
+func (a *AtomicBool) Error() string {
+	return a.Inspect()
+}
+
+func (*AtomicBool) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (a *AtomicBool) Load() bool {
+	return a.native.Load()
+}
+
+func (a *AtomicBool) Store(val bool) {
+	a.native.Store(val)
+}
+
+// Store the new value and return the old one.
+func (a *AtomicBool) Swap(val bool) bool {
+	return a.native.Swap(val)
+}
+
+// Store the new value only when the current value is equal to `expected`.
+func (a *AtomicBool) CompareAndSwap(expected, val bool) bool {
+	return a.native.CompareAndSwap(expected, val)
+}
+
+func initAtomicBool() {
+	AtomicBoolClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("AtomicBool", Ref(AtomicBoolClass))
+	RegisterNativeClass("Std::Sync::AtomicBool", "value.AtomicBoolClass")
+}
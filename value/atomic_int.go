@@ -0,0 +1,85 @@
+package value
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+var AtomicIntClass *Class // ::Std::Sync::AtomicInt
+
+// An integer that can be safely read and modified by multiple threads.
+type AtomicInt struct {
+	native atomic.Int64
+}
+
+func NewAtomicInt(val int64) *AtomicInt {
+	a := &AtomicInt{}
+	a.native.Store(val)
+	return a
+}
+
+func (a *AtomicInt) Copy() Reference {
+	return a
+}
+
+func (a *AtomicInt) ToValue() Value {
+	return Ref(a)
+}
+
+func (*AtomicInt) Class() *Class {
+	return AtomicIntClass
+}
+
+func (*AtomicInt) DirectClass() *Class {
+	return AtomicIntClass
+}
+
+func (*AtomicInt) SingletonClass() *Class {
+	return nil
+}
+
+func (a *AtomicInt) Inspect() string {
+	return fmt.Sprintf("Std::Sync::AtomicInt{&: %p, value: %d}", a, a.Load())
+}
+
+func (a *AtomicInt) Error() string {
+	return a.Inspect()
+}
+
+func (*AtomicInt) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (a *AtomicInt) Load() int64 {
+	return a.native.Load()
+}
+
+func (a *AtomicInt) Store(val int64) {
+	a.native.Store(val)
+}
+
+// Store the new value and return the old one.
+func (a *AtomicInt) Swap(val int64) int64 {
+	return a.native.Swap(val)
+}
+
+// Store the new value only when the current value is equal to `expected`.
+func (a *AtomicInt) CompareAndSwap(expected, val int64) bool {
+	return a.native.CompareAndSwap(expected, val)
+}
+
+// Add `delta` and return the new value.
+func (a *AtomicInt) Add(delta int64) int64 {
+	return a.native.Add(delta)
+}
+
+// Subtract `delta` and return the new value.
+func (a *AtomicInt) Sub(delta int64) int64 {
+	return a.native.Add(-delta)
+}
+
+func initAtomicInt() {
+	AtomicIntClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("AtomicInt", Ref(AtomicIntClass))
+	RegisterNativeClass("Std::Sync::AtomicInt", "value.AtomicIntClass")
+}
@@ -0,0 +1,76 @@
+package value
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+var AtomicRefClass *Class // ::Std::Sync::AtomicRef
+
+// A reference to a value that can be safely read and replaced by multiple threads.
+// Values are compared by identity.
+type AtomicRef struct {
+	native atomic.Value
+}
+
+func NewAtomicRef(val Value) *AtomicRef {
+	a := &AtomicRef{}
+	a.native.Store(val)
+	return a
+}
+
+func (a *AtomicRef) Copy() Reference {
+	return a
+}
+
+func (a *AtomicRef) ToValue() Value {
+	return Ref(a)
+}
+
+func (*AtomicRef) Class() *Class {
+	return AtomicRefClass
+}
+
+func (*AtomicRef) DirectClass() *Class {
+	return AtomicRefClass
+}
+
+func (*AtomicRef) SingletonClass() *Class {
+	return nil
+}
+
+func (a *AtomicRef) Inspect() string {
+	return fmt.Sprintf("Std::Sync::AtomicRef{&: %p, value: %s}", a, a.Load().Inspect())
+}
+
+func (a *AtomicRef) Error() string {
+	return a.Inspect()
+}
+
+func (*AtomicRef) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (a *AtomicRef) Load() Value {
+	return a.native.Load().(Value)
+}
+
+func (a *AtomicRef) Store(val Value) {
+	a.native.Store(val)
+}
+
+// Store the new value and return the old one.
+func (a *AtomicRef) Swap(val Value) Value {
+	return a.native.Swap(val).(Value)
+}
+
+// Store the new value only when the current value is identical to `expected`.
+func (a *AtomicRef) CompareAndSwap(expected, val Value) bool {
+	return a.native.CompareAndSwap(expected, val)
+}
+
+func initAtomicRef() {
+	AtomicRefClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("AtomicRef", Ref(AtomicRefClass))
+	RegisterNativeClass("Std::Sync::AtomicRef", "value.AtomicRefClass")
+}
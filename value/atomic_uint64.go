@@ -0,0 +1,85 @@
+package value
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+var AtomicUInt64Class *Class // ::Std::Sync::AtomicUInt64
+
+// A `UInt64` that can be safely read and modified by multiple threads.
+type AtomicUInt64 struct {
+	native atomic.Uint64
+}
+
+func NewAtomicUInt64(val UInt64) *AtomicUInt64 {
+	a := &AtomicUInt64{}
+	a.native.Store(uint64(val))
+	return a
+}
+
+func (a *AtomicUInt64) Copy() Reference {
+	return a
+}
+
+func (a *AtomicUInt64) ToValue() Value {
+	return Ref(a)
+}
+
+func (*AtomicUInt64) Class() *Class {
+	return AtomicUInt64Class
+}
+
+func (*AtomicUInt64) DirectClass() *Class {
+	return AtomicUInt64Class
+}
+
+func (*AtomicUInt64) SingletonClass() *Class {
+	return nil
+}
+
+func (a *AtomicUInt64) Inspect() string {
+	return fmt.Sprintf("Std::Sync::AtomicUInt64{&: %p, value: %du64}", a, a.Load())
+}
+
+func (a *AtomicUInt64) Error() string {
+	return a.Inspect()
+}
+
+func (*AtomicUInt64) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (a *AtomicUInt64) Load() UInt64 {
+	return UInt64(a.native.Load())
+}
+
+func (a *AtomicUInt64) Store(val UInt64) {
+	a.native.Store(uint64(val))
+}
+
+// Store the new value and return the old one.
+func (a *AtomicUInt64) Swap(val UInt64) UInt64 {
+	return UInt64(a.native.Swap(uint64(val)))
+}
+
+// Store the new value only when the current value is equal to `expected`.
+func (a *AtomicUInt64) CompareAndSwap(expected, val UInt64) bool {
+	return a.native.CompareAndSwap(uint64(expected), uint64(val))
+}
+
+// Add `delta` and return the new value.
+func (a *AtomicUInt64) Add(delta UInt64) UInt64 {
+	return UInt64(a.native.Add(uint64(delta)))
+}
+
+// Subtract `delta` and return the new value.
+func (a *AtomicUInt64) Sub(delta UInt64) UInt64 {
+	return UInt64(a.native.Add(^uint64(delta - 1)))
+}
+
+func initAtomicUInt64() {
+	AtomicUInt64Class = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("AtomicUInt64", Ref(AtomicUInt64Class))
+	RegisterNativeClass("Std::Sync::AtomicUInt64", "value.AtomicUInt64Class")
+}
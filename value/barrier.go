@@ -0,0 +1,112 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+var BarrierClass *Class // ::Std::Sync::Barrier
+
+// A reusable barrier that blocks threads
+// until a fixed number of them is waiting.
+type Barrier struct {
+	parties int
+	m       sync.Mutex
+	waiting int
+	// gets closed when the current generation of the barrier trips
+	tripped chan struct{}
+}
+
+func NewBarrier(parties int) *Barrier {
+	return &Barrier{
+		parties: parties,
+		tripped: make(chan struct{}),
+	}
+}
+
+func (b *Barrier) Copy() Reference {
+	return b
+}
+
+func (b *Barrier) ToValue() Value {
+	return Ref(b)
+}
+
+func (*Barrier) Class() *Class {
+	return BarrierClass
+}
+
+func (*Barrier) DirectClass() *Class {
+	return BarrierClass
+}
+
+func (*Barrier) SingletonClass() *Class {
+	return nil
+}
+
+func (b *Barrier) Inspect() string {
+	return fmt.Sprintf("Std::Sync::Barrier{&: %p, parties: %d, waiting_count: %d}", b, b.parties, b.WaitingCount())
+}
+
+func (b *Barrier) Error() string {
+	return b.Inspect()
+}
+
+func (*Barrier) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the number of threads required to trip the barrier.
+func (b *Barrier) Parties() int {
+	return b.parties
+}
+
+// Returns the number of threads currently waiting on the barrier.
+func (b *Barrier) WaitingCount() int {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	return b.waiting
+}
+
+// Block until all parties are waiting on the barrier
+// or the context is done.
+// Returns true in the thread that tripped the barrier.
+// A cancelled thread stops being counted as waiting.
+func (b *Barrier) Wait(ctx context.Context) (last bool, err Value) {
+	b.m.Lock()
+	b.waiting++
+	if b.waiting == b.parties {
+		close(b.tripped)
+		b.tripped = make(chan struct{})
+		b.waiting = 0
+		b.m.Unlock()
+		return true, Undefined
+	}
+	tripped := b.tripped
+	b.m.Unlock()
+
+	select {
+	case <-tripped:
+		return false, Undefined
+	case <-ctx.Done():
+		b.m.Lock()
+		defer b.m.Unlock()
+
+		select {
+		case <-tripped:
+			// the barrier has tripped in the meantime
+			return false, Undefined
+		default:
+		}
+		b.waiting--
+		return false, ExecutionAbortedError.ToValue()
+	}
+}
+
+func initBarrier() {
+	BarrierClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("Barrier", Ref(BarrierClass))
+	RegisterNativeClass("Std::Sync::Barrier", "value.BarrierClass")
+}
@@ -0,0 +1,127 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+var CondClass *Class // ::Std::Sync::Cond
+
+// A condition variable tied to a mutex.
+// Unlike `sync.Cond` waiting can be cancelled with a context.
+type Cond struct {
+	Mutex   *Mutex
+	m       sync.Mutex
+	waiters []chan struct{}
+}
+
+func NewCond(mutex *Mutex) *Cond {
+	return &Cond{
+		Mutex: mutex,
+	}
+}
+
+func (c *Cond) Copy() Reference {
+	return c
+}
+
+func (c *Cond) ToValue() Value {
+	return Ref(c)
+}
+
+func (*Cond) Class() *Class {
+	return CondClass
+}
+
+func (*Cond) DirectClass() *Class {
+	return CondClass
+}
+
+func (*Cond) SingletonClass() *Class {
+	return nil
+}
+
+func (c *Cond) Inspect() string {
+	return fmt.Sprintf("Std::Sync::Cond{&: %p, mutex: %s}", c, c.Mutex.Inspect())
+}
+
+func (c *Cond) Error() string {
+	return c.Inspect()
+}
+
+func (*Cond) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Unlock the mutex, block until the condition gets signalled
+// or the context is done and lock the mutex again.
+// The mutex has to be locked by the caller.
+func (c *Cond) Wait(ctx context.Context) (err Value) {
+	waiter := make(chan struct{})
+	c.m.Lock()
+	c.waiters = append(c.waiters, waiter)
+	c.m.Unlock()
+
+	if err := c.Mutex.Unlock(); !err.IsUndefined() {
+		c.removeWaiter(waiter)
+		return err
+	}
+	defer c.Mutex.Lock()
+
+	select {
+	case <-waiter:
+		return Undefined
+	case <-ctx.Done():
+		if !c.removeWaiter(waiter) {
+			// the waiter has been signalled in the meantime,
+			// pass the signal on so that it does not get lost
+			c.Signal()
+		}
+		return ExecutionAbortedError.ToValue()
+	}
+}
+
+// Remove the waiter from the queue.
+// Returns false when it has already been signalled.
+func (c *Cond) removeWaiter(waiter chan struct{}) bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	i := slices.Index(c.waiters, waiter)
+	if i == -1 {
+		return false
+	}
+	c.waiters = slices.Delete(c.waiters, i, i+1)
+	return true
+}
+
+// Wake up one waiting thread.
+func (c *Cond) Signal() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if len(c.waiters) == 0 {
+		return
+	}
+	close(c.waiters[0])
+	c.waiters = slices.Delete(c.waiters, 0, 1)
+}
+
+// Wake up all waiting threads.
+func (c *Cond) Broadcast() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for _, waiter := range c.waiters {
+		close(waiter)
+	}
+	c.waiters = nil
+}
+
+func initCond() {
+	CondClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("Cond", Ref(CondClass))
+	RegisterNativeClass("Std::Sync::Cond", "value.CondClass")
+}
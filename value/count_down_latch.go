@@ -0,0 +1,102 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+var CountDownLatchClass *Class // ::Std::Sync::CountDownLatch
+
+// A one-shot latch that releases waiting threads
+// once its counter reaches zero.
+type CountDownLatch struct {
+	m     sync.Mutex
+	count int
+	// gets closed when the counter reaches zero
+	done chan struct{}
+}
+
+func NewCountDownLatch(count int) *CountDownLatch {
+	l := &CountDownLatch{
+		count: count,
+		done:  make(chan struct{}),
+	}
+	if count == 0 {
+		close(l.done)
+	}
+	return l
+}
+
+func (l *CountDownLatch) Copy() Reference {
+	return l
+}
+
+func (l *CountDownLatch) ToValue() Value {
+	return Ref(l)
+}
+
+func (*CountDownLatch) Class() *Class {
+	return CountDownLatchClass
+}
+
+func (*CountDownLatch) DirectClass() *Class {
+	return CountDownLatchClass
+}
+
+func (*CountDownLatch) SingletonClass() *Class {
+	return nil
+}
+
+func (l *CountDownLatch) Inspect() string {
+	return fmt.Sprintf("Std::Sync::CountDownLatch{&: %p, count: %d}", l, l.Count())
+}
+
+func (l *CountDownLatch) Error() string {
+	return l.Inspect()
+}
+
+func (*CountDownLatch) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the current value of the counter.
+func (l *CountDownLatch) Count() int {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	return l.count
+}
+
+// Decrement the counter.
+// Releases all waiting threads when it reaches zero.
+// Does nothing when the counter is already zero.
+func (l *CountDownLatch) CountDown() {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	if l.count == 0 {
+		return
+	}
+	l.count--
+	if l.count == 0 {
+		close(l.done)
+	}
+}
+
+// Block until the counter reaches zero
+// or the context is done.
+func (l *CountDownLatch) Wait(ctx context.Context) (err Value) {
+	select {
+	case <-l.done:
+		return Undefined
+	case <-ctx.Done():
+		return ExecutionAbortedError.ToValue()
+	}
+}
+
+func initCountDownLatch() {
+	CountDownLatchClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("CountDownLatch", Ref(CountDownLatchClass))
+	RegisterNativeClass("Std::Sync::CountDownLatch", "value.CountDownLatchClass")
+}
@@ -110,6 +110,12 @@ var FormatErrorClass *Class
 // Thrown when a Regex could not be compiled.
 var RegexCompileErrorClass *Class
 
+// ::Std::RegexStepLimitError
+//
+// Thrown when a search of a backtracking Regex
+// exceeds the step limit.
+var RegexStepLimitErrorClass *Class
+
 // ::Std::SealedClassError
 //
 // Thrown when trying to inherit
@@ -710,6 +716,10 @@ func initError() {
 	StdModule.AddConstantString("RegexCompileError", Ref(RegexCompileErrorClass))
 	RegisterNativeClass("Std::RegexCompileError", "value.RegexCompileErrorClass")
 
+	RegexStepLimitErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	StdModule.AddConstantString("RegexStepLimitError", Ref(RegexStepLimitErrorClass))
+	RegisterNativeClass("Std::RegexStepLimitError", "value.RegexStepLimitErrorClass")
+
 	NoMethodErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
 	StdModule.AddConstantString("NoMethodError", Ref(NoMethodErrorClass))
 	RegisterNativeClass("Std::NoMethodError", "value.NoMethodErrorClass")
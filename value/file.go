@@ -0,0 +1,249 @@
+package value
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var FileClass *Class // ::Std::FS::File
+
+func initFile() {
+	FileClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	FSModule.AddConstantString("File", Ref(FileClass))
+	RegisterNativeClass("Std::FS::File", "value.FileClass")
+}
+
+// Describes how a file is opened.
+type FileMode uint8
+
+const (
+	FileModeRead      FileMode = iota // open for reading
+	FileModeWrite                     // create or truncate and open for writing
+	FileModeAppend                    // create and open for appending
+	FileModeReadWrite                 // create and open for reading and writing
+)
+
+var fileModeNames = [...]string{
+	FileModeRead:      "read",
+	FileModeWrite:     "write",
+	FileModeAppend:    "append",
+	FileModeReadWrite: "read_write",
+}
+
+// Returns the file mode with the given name.
+func FileModeFromName(name string) (FileMode, bool) {
+	for mode, modeName := range fileModeNames {
+		if modeName == name {
+			return FileMode(mode), true
+		}
+	}
+
+	return 0, false
+}
+
+func (m FileMode) String() string {
+	return fileModeNames[m]
+}
+
+func (m FileMode) flag() int {
+	switch m {
+	case FileModeWrite:
+		return os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	case FileModeAppend:
+		return os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	case FileModeReadWrite:
+		return os.O_RDWR | os.O_CREATE
+	default:
+		return os.O_RDONLY
+	}
+}
+
+// An open file with buffered reads and writes.
+// It is safe to use by multiple threads.
+type File struct {
+	Path   string
+	Mode   FileMode
+	m      sync.Mutex
+	native *os.File
+	reader *bufio.Reader
+	writer *bufio.Writer
+}
+
+// Open the file at the given path.
+func OpenFile(path string, mode FileMode, perm os.FileMode) (*File, error) {
+	native, err := os.OpenFile(path, mode.flag(), perm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &File{
+		Path:   path,
+		Mode:   mode,
+		native: native,
+		reader: bufio.NewReader(native),
+		writer: bufio.NewWriter(native),
+	}, nil
+}
+
+func (*File) Class() *Class {
+	return FileClass
+}
+
+func (*File) DirectClass() *Class {
+	return FileClass
+}
+
+func (*File) SingletonClass() *Class {
+	return nil
+}
+
+func (f *File) Copy() Reference {
+	return f
+}
+
+func (f *File) ToValue() Value {
+	return Ref(f)
+}
+
+func (f *File) Inspect() string {
+	return fmt.Sprintf(
+		"Std::FS::File{&: %p, path: %s, mode: :%s}",
+		f,
+		String(f.Path).Inspect(),
+		f.Mode,
+	)
+}
+
+func (f *File) Error() string {
+	return f.Inspect()
+}
+
+func (*File) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Reports whether the file has been closed.
+func (f *File) IsClosed() bool {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	return f.native == nil
+}
+
+// Returns information about the file.
+func (f *File) Stat() (*FileInfo, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if f.native == nil {
+		return nil, os.ErrClosed
+	}
+	info, err := f.native.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return NewFileInfo(info), nil
+}
+
+// Read the next line without the trailing line break.
+// Returns false when there are no more lines to read.
+func (f *File) ReadLine() (string, bool, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if err := f.prepareRead(); err != nil {
+		return "", false, err
+	}
+	return ReadLine(f.reader)
+}
+
+func (f *File) Read(p []byte) (int, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if err := f.prepareRead(); err != nil {
+		return 0, err
+	}
+	return f.reader.Read(p)
+}
+
+func (f *File) Write(p []byte) (int, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if err := f.prepareWrite(); err != nil {
+		return 0, err
+	}
+	return f.writer.Write(p)
+}
+
+// Write a string to the file.
+// Returns the number of written bytes.
+func (f *File) WriteString(content string) (int, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if err := f.prepareWrite(); err != nil {
+		return 0, err
+	}
+	return f.writer.WriteString(content)
+}
+
+// Write the buffered data to the file.
+func (f *File) Flush() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if f.native == nil {
+		return os.ErrClosed
+	}
+	return f.writer.Flush()
+}
+
+// Flush the buffered data and close the file.
+// Closing a closed file is a no-op.
+func (f *File) Close() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if f.native == nil {
+		return nil
+	}
+
+	flushErr := f.writer.Flush()
+	closeErr := f.native.Close()
+	f.native = nil
+	if flushErr != nil {
+		return flushErr
+	}
+	return closeErr
+}
+
+// Flush pending writes so that they are visible to reads.
+func (f *File) prepareRead() error {
+	if f.native == nil {
+		return os.ErrClosed
+	}
+	return f.writer.Flush()
+}
+
+// Rewind the file by the amount of data that has been read ahead
+// so that writes start right after the last read character.
+func (f *File) prepareWrite() error {
+	if f.native == nil {
+		return os.ErrClosed
+	}
+
+	buffered := f.reader.Buffered()
+	if buffered == 0 {
+		return nil
+	}
+	if _, err := f.native.Seek(-int64(buffered), io.SeekCurrent); err != nil {
+		return err
+	}
+	f.reader.Reset(f.native)
+	return nil
+}
@@ -0,0 +1,77 @@
+package value
+
+import (
+	"fmt"
+	"io/fs"
+)
+
+var FileInfoClass *Class // ::Std::FS::FileInfo
+
+func initFileInfo() {
+	FileInfoClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	FSModule.AddConstantString("FileInfo", Ref(FileInfoClass))
+	RegisterNativeClass("Std::FS::FileInfo", "value.FileInfoClass")
+}
+
+// Describes a file or directory.
+type FileInfo struct {
+	Native fs.FileInfo
+}
+
+func NewFileInfo(native fs.FileInfo) *FileInfo {
+	return &FileInfo{
+		Native: native,
+	}
+}
+
+func (*FileInfo) Class() *Class {
+	return FileInfoClass
+}
+
+func (*FileInfo) DirectClass() *Class {
+	return FileInfoClass
+}
+
+func (*FileInfo) SingletonClass() *Class {
+	return nil
+}
+
+func (f *FileInfo) Copy() Reference {
+	return f
+}
+
+func (f *FileInfo) ToValue() Value {
+	return Ref(f)
+}
+
+func (f *FileInfo) Inspect() string {
+	return fmt.Sprintf(
+		"Std::FS::FileInfo{name: %s, size: %d, mode: %s}",
+		String(f.Native.Name()).Inspect(),
+		f.Native.Size(),
+		f.Native.Mode(),
+	)
+}
+
+func (f *FileInfo) Error() string {
+	return f.Inspect()
+}
+
+func (*FileInfo) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the Unix permission bits of the file.
+func (f *FileInfo) Permissions() int64 {
+	return int64(f.Native.Mode().Perm())
+}
+
+// Reports whether the file is a regular file.
+func (f *FileInfo) IsFile() bool {
+	return f.Native.Mode().IsRegular()
+}
+
+// Reports whether the file is a symbolic link.
+func (f *FileInfo) IsSymlink() bool {
+	return f.Native.Mode()&fs.ModeSymlink != 0
+}
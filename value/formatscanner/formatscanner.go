@@ -0,0 +1,184 @@
+// Package formatscanner implements a tokenizer/lexer
+// that analyses Elk string format strings like `%-10s %08.3f`.
+package formatscanner
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Maximum value of the width and precision of a specifier.
+const MaxWidth = 1_000_000
+
+// Verbs that can be used in format specifiers.
+const Verbs = "spdixXobeEfFgGc"
+
+// Represents a single element of a format string.
+// Only `Value` and `Start` are set for text.
+type Specifier struct {
+	Value string // source text of the element
+	Start int    // byte offset of the element in the format string
+
+	Minus bool // `-` left justify
+	Plus  bool // `+` always print the sign of numbers
+	Space bool // ` ` leave a space for the sign of positive numbers
+	Zero  bool // `0` pad with leading zeros
+	Hash  bool // `#` alternate format, add a radix prefix like `0x`
+
+	Width     int // minimum width, -1 when absent
+	Precision int // precision, -1 when absent
+	Verb      rune
+}
+
+// Scans a format string and produces
+// text and format specifiers.
+type Formatscanner struct {
+	fmtString string
+	cursor    int
+	start     int
+}
+
+func New(fmtString string) *Formatscanner {
+	return &Formatscanner{
+		fmtString: fmtString,
+	}
+}
+
+func (f *Formatscanner) Next() (Token, Specifier) {
+	if !f.hasMoreTokens() {
+		return END_OF_FILE, Specifier{Start: f.cursor}
+	}
+
+	token, spec := f.scan()
+	spec.Value = f.value()
+	spec.Start = f.start
+	f.start = f.cursor
+	return token, spec
+}
+
+// Returns true if there is any text left to analyse.
+func (f *Formatscanner) hasMoreTokens() bool {
+	return f.cursor < len(f.fmtString)
+}
+
+// Gets the next byte without incrementing the cursor.
+func (f *Formatscanner) peekByte() byte {
+	if !f.hasMoreTokens() {
+		return 0
+	}
+	return f.fmtString[f.cursor]
+}
+
+func (f *Formatscanner) value() string {
+	return f.fmtString[f.start:f.cursor]
+}
+
+func (f *Formatscanner) scan() (Token, Specifier) {
+	if f.peekByte() != '%' {
+		i := strings.IndexByte(f.fmtString[f.cursor:], '%')
+		if i == -1 {
+			f.cursor = len(f.fmtString)
+		} else {
+			f.cursor += i
+		}
+		return TEXT, Specifier{}
+	}
+
+	f.cursor++
+	if f.peekByte() == '%' {
+		f.cursor++
+		return PERCENT, Specifier{}
+	}
+
+	return f.specifier()
+}
+
+func (f *Formatscanner) specifier() (Token, Specifier) {
+	spec := Specifier{
+		Width:     -1,
+		Precision: -1,
+	}
+
+flagLoop:
+	for {
+		switch f.peekByte() {
+		case '-':
+			spec.Minus = true
+		case '+':
+			spec.Plus = true
+		case ' ':
+			spec.Space = true
+		case '0':
+			spec.Zero = true
+		case '#':
+			spec.Hash = true
+		default:
+			break flagLoop
+		}
+		f.cursor++
+	}
+
+	var ok bool
+	spec.Width, ok = f.number()
+	if !ok {
+		return f.invalid(), spec
+	}
+	if f.peekByte() == '.' {
+		f.cursor++
+		spec.Precision, ok = f.number()
+		if !ok {
+			return f.invalid(), spec
+		}
+		if spec.Precision == -1 {
+			spec.Precision = 0
+		}
+	}
+
+	if !f.hasMoreTokens() {
+		return INVALID_SPECIFIER, spec
+	}
+	verb, size := utf8.DecodeRuneInString(f.fmtString[f.cursor:])
+	f.cursor += size
+	if !strings.ContainsRune(Verbs, verb) {
+		return INVALID_SPECIFIER, spec
+	}
+	spec.Verb = verb
+	return SPECIFIER, spec
+}
+
+// Consume the rest of a malformed specifier.
+func (f *Formatscanner) invalid() Token {
+	for f.hasMoreTokens() {
+		b := f.peekByte()
+		if (b < '0' || b > '9') && b != '.' {
+			break
+		}
+		f.cursor++
+	}
+	if f.hasMoreTokens() && strings.IndexByte(Verbs, f.peekByte()) != -1 {
+		f.cursor++
+	}
+	return INVALID_SPECIFIER
+}
+
+// Scan a decimal number.
+// Returns -1 when there are no digits
+// and false when the number is too large.
+func (f *Formatscanner) number() (int, bool) {
+	result := -1
+	for f.hasMoreTokens() {
+		b := f.peekByte()
+		if b < '0' || b > '9' {
+			break
+		}
+		if result == -1 {
+			result = 0
+		}
+		result = result*10 + int(b-'0')
+		if result > MaxWidth {
+			return result, false
+		}
+		f.cursor++
+	}
+	return result, true
+}
@@ -0,0 +1,163 @@
+package formatscanner_test
+
+import (
+	"testing"
+
+	"github.com/elk-language/elk/value/formatscanner"
+	"github.com/google/go-cmp/cmp"
+)
+
+type tokenSpecifier struct {
+	Token     formatscanner.Token
+	Specifier formatscanner.Specifier
+}
+
+func T(token formatscanner.Token, value string, start int) tokenSpecifier {
+	return tokenSpecifier{
+		Token: token,
+		Specifier: formatscanner.Specifier{
+			Value: value,
+			Start: start,
+		},
+	}
+}
+
+func S(spec formatscanner.Specifier) tokenSpecifier {
+	return tokenSpecifier{
+		Token:     formatscanner.SPECIFIER,
+		Specifier: spec,
+	}
+}
+
+func I(value string, start int) tokenSpecifier {
+	return tokenSpecifier{
+		Token: formatscanner.INVALID_SPECIFIER,
+		Specifier: formatscanner.Specifier{
+			Value:     value,
+			Start:     start,
+			Width:     -1,
+			Precision: -1,
+		},
+	}
+}
+
+// Represents a single test case.
+type testCase struct {
+	input string
+	want  []tokenSpecifier
+}
+
+// Type of the test table.
+type testTable map[string]testCase
+
+// Function which powers all formatscanner tests.
+// Inspects if the produced stream of tokens
+// matches the expected one.
+func tokenTest(tc testCase, t *testing.T) {
+	t.Helper()
+	scanner := formatscanner.New(tc.input)
+	var got []tokenSpecifier
+	for {
+		tok, spec := scanner.Next()
+		if tok == formatscanner.END_OF_FILE {
+			break
+		}
+		got = append(got, tokenSpecifier{Token: tok, Specifier: spec})
+	}
+	diff := cmp.Diff(tc.want, got)
+	if diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestFormatscanner(t *testing.T) {
+	tests := testTable{
+		"empty format string": {
+			input: "",
+			want:  nil,
+		},
+		"only text": {
+			input: "foo bar",
+			want: []tokenSpecifier{
+				T(formatscanner.TEXT, "foo bar", 0),
+			},
+		},
+		"percent": {
+			input: "100%%",
+			want: []tokenSpecifier{
+				T(formatscanner.TEXT, "100", 0),
+				T(formatscanner.PERCENT, "%%", 3),
+			},
+		},
+		"simple specifier": {
+			input: "name: %s!",
+			want: []tokenSpecifier{
+				T(formatscanner.TEXT, "name: ", 0),
+				S(formatscanner.Specifier{Value: "%s", Start: 6, Width: -1, Precision: -1, Verb: 's'}),
+				T(formatscanner.TEXT, "!", 8),
+			},
+		},
+		"flags, width and precision": {
+			input: "%-10s %+08.3f",
+			want: []tokenSpecifier{
+				S(formatscanner.Specifier{Value: "%-10s", Start: 0, Minus: true, Width: 10, Precision: -1, Verb: 's'}),
+				T(formatscanner.TEXT, " ", 5),
+				S(formatscanner.Specifier{Value: "%+08.3f", Start: 6, Plus: true, Zero: true, Width: 8, Precision: 3, Verb: 'f'}),
+			},
+		},
+		"alternate format": {
+			input: "%# x",
+			want: []tokenSpecifier{
+				S(formatscanner.Specifier{Value: "%# x", Start: 0, Hash: true, Space: true, Width: -1, Precision: -1, Verb: 'x'}),
+			},
+		},
+		"precision without digits": {
+			input: "%.f",
+			want: []tokenSpecifier{
+				S(formatscanner.Specifier{Value: "%.f", Start: 0, Width: -1, Precision: 0, Verb: 'f'}),
+			},
+		},
+		"unknown verb": {
+			input: "%5q foo",
+			want: []tokenSpecifier{
+				{
+					Token: formatscanner.INVALID_SPECIFIER,
+					Specifier: formatscanner.Specifier{
+						Value:     "%5q",
+						Start:     0,
+						Width:     5,
+						Precision: -1,
+					},
+				},
+				T(formatscanner.TEXT, " foo", 3),
+			},
+		},
+		"unterminated specifier": {
+			input: "foo %",
+			want: []tokenSpecifier{
+				T(formatscanner.TEXT, "foo ", 0),
+				I("%", 4),
+			},
+		},
+		"width too large": {
+			input: "%99999999d",
+			want: []tokenSpecifier{
+				{
+					Token: formatscanner.INVALID_SPECIFIER,
+					Specifier: formatscanner.Specifier{
+						Value:     "%99999999d",
+						Start:     0,
+						Width:     9999999,
+						Precision: -1,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokenTest(tc, t)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package formatscanner
+
+type Token uint8
+
+// Name of the token.
+func (t Token) String() string {
+	if int(t) >= len(tokenNames) {
+		return "UNKNOWN"
+	}
+
+	return tokenNames[t]
+}
+
+const (
+	ZERO_VALUE        Token = iota // Zero value for Type
+	INVALID_SPECIFIER              // Invalid format specifier
+	END_OF_FILE                    // End Of File has been reached
+	PERCENT                        // "%%" Literal percent
+	TEXT                           // Literal text
+	SPECIFIER                      // Format specifier like "%-10s"
+)
+
+var tokenNames = [...]string{
+	INVALID_SPECIFIER: "INVALID_SPECIFIER",
+	END_OF_FILE:       "END_OF_FILE",
+	PERCENT:           "PERCENT",
+	TEXT:              "TEXT",
+	SPECIFIER:         "SPECIFIER",
+}
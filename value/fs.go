@@ -1,9 +1,57 @@
 package value
 
-var FSModule *Module // ::Std::FS
+import (
+	"errors"
+	"io/fs"
+)
+
+var FSModule *Module              // ::Std::FS
+var FSNotFoundErrorClass *Class   // ::Std::FS::NotFoundError
+var FSExistsErrorClass *Class     // ::Std::FS::ExistsError
+var FSPermissionErrorClass *Class // ::Std::FS::PermissionError
+var FSClosedErrorClass *Class     // ::Std::FS::ClosedError
 
 func initFS() {
 	FSModule = NewModule()
 	StdModule.AddConstantString("FS", Ref(FSModule))
 	RegisterNativeModule("Std::FS", "value.FSModule")
 }
+
+func initFSErrors() {
+	FSNotFoundErrorClass = NewClassWithOptions(ClassWithSuperclass(FileSystemErrorClass))
+	FSModule.AddConstantString("NotFoundError", Ref(FSNotFoundErrorClass))
+	RegisterNativeClass("Std::FS::NotFoundError", "value.FSNotFoundErrorClass")
+
+	FSExistsErrorClass = NewClassWithOptions(ClassWithSuperclass(FileSystemErrorClass))
+	FSModule.AddConstantString("ExistsError", Ref(FSExistsErrorClass))
+	RegisterNativeClass("Std::FS::ExistsError", "value.FSExistsErrorClass")
+
+	FSPermissionErrorClass = NewClassWithOptions(ClassWithSuperclass(FileSystemErrorClass))
+	FSModule.AddConstantString("PermissionError", Ref(FSPermissionErrorClass))
+	RegisterNativeClass("Std::FS::PermissionError", "value.FSPermissionErrorClass")
+
+	FSClosedErrorClass = NewClassWithOptions(ClassWithSuperclass(FileSystemErrorClass))
+	FSModule.AddConstantString("ClosedError", Ref(FSClosedErrorClass))
+	RegisterNativeClass("Std::FS::ClosedError", "value.FSClosedErrorClass")
+}
+
+// Convert a Go file system error to an Elk error.
+// The class of the error is a subclass of `Std::FileSystemError`
+// that best describes the cause.
+func NewFSError(err error) Value {
+	var class *Class
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		class = FSNotFoundErrorClass
+	case errors.Is(err, fs.ErrExist):
+		class = FSExistsErrorClass
+	case errors.Is(err, fs.ErrPermission):
+		class = FSPermissionErrorClass
+	case errors.Is(err, fs.ErrClosed):
+		class = FSClosedErrorClass
+	default:
+		class = FileSystemErrorClass
+	}
+
+	return Ref(NewError(class, err.Error()))
+}
@@ -0,0 +1,449 @@
+package value
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+var FSWalkerClass *Class       // ::Std::FS::Walker
+var FSDirEntryClass *Class     // ::Std::FS::DirEntry
+var FSGlobIteratorClass *Class // ::Std::FS::GlobIterator
+
+func initFSWalker() {
+	FSWalkerClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	FSModule.AddConstantString("Walker", Ref(FSWalkerClass))
+	RegisterNativeClass("Std::FS::Walker", "value.FSWalkerClass")
+
+	FSDirEntryClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	FSModule.AddConstantString("DirEntry", Ref(FSDirEntryClass))
+	RegisterNativeClass("Std::FS::DirEntry", "value.FSDirEntryClass")
+
+	FSGlobIteratorClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	FSModule.AddConstantString("GlobIterator", Ref(FSGlobIteratorClass))
+	RegisterNativeClass("Std::FS::GlobIterator", "value.FSGlobIteratorClass")
+}
+
+// Determines how symbolic links are treated
+// when walking a directory tree.
+type SymlinkPolicy uint8
+
+const (
+	SymlinkNoFollow SymlinkPolicy = iota // yield symbolic links without following them
+	SymlinkFollow                        // descend into symbolic links to directories
+	SymlinkSkip                          // do not yield symbolic links
+)
+
+var symlinkPolicyNames = [...]string{
+	SymlinkNoFollow: "no_follow",
+	SymlinkFollow:   "follow",
+	SymlinkSkip:     "skip",
+}
+
+// Returns the symlink policy with the given name.
+func SymlinkPolicyFromName(name string) (SymlinkPolicy, bool) {
+	for policy, policyName := range symlinkPolicyNames {
+		if policyName == name {
+			return SymlinkPolicy(policy), true
+		}
+	}
+
+	return 0, false
+}
+
+func (s SymlinkPolicy) String() string {
+	return symlinkPolicyNames[s]
+}
+
+// An entry of a directory tree yielded by `FSWalker`.
+type FSDirEntry struct {
+	Path  string // path of the entry including the root
+	Depth int    // depth of the entry, children of the root have depth 1
+	rel   string // slash-separated path relative to the root
+	entry fs.DirEntry
+	// Set when the entry is a followed symbolic link
+	// and describes its target.
+	target fs.FileInfo
+}
+
+func (*FSDirEntry) Class() *Class {
+	return FSDirEntryClass
+}
+
+func (*FSDirEntry) DirectClass() *Class {
+	return FSDirEntryClass
+}
+
+func (*FSDirEntry) SingletonClass() *Class {
+	return nil
+}
+
+func (e *FSDirEntry) Copy() Reference {
+	return e
+}
+
+func (e *FSDirEntry) ToValue() Value {
+	return Ref(e)
+}
+
+func (e *FSDirEntry) Inspect() string {
+	return fmt.Sprintf(
+		"Std::FS::DirEntry{path: %s, depth: %d, dir: %t}",
+		String(e.Path).Inspect(),
+		e.Depth,
+		e.IsDir(),
+	)
+}
+
+func (e *FSDirEntry) Error() string {
+	return e.Inspect()
+}
+
+func (*FSDirEntry) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the base name of the entry.
+func (e *FSDirEntry) Name() string {
+	return e.entry.Name()
+}
+
+// Reports whether the entry is a directory
+// or a followed symbolic link to a directory.
+func (e *FSDirEntry) IsDir() bool {
+	if e.target != nil {
+		return e.target.IsDir()
+	}
+	return e.entry.IsDir()
+}
+
+// Reports whether the entry is a regular file
+// or a followed symbolic link to a regular file.
+func (e *FSDirEntry) IsFile() bool {
+	if e.target != nil {
+		return e.target.Mode().IsRegular()
+	}
+	return e.entry.Type().IsRegular()
+}
+
+// Reports whether the entry is a symbolic link.
+func (e *FSDirEntry) IsSymlink() bool {
+	return e.entry.Type()&fs.ModeSymlink != 0
+}
+
+// Returns information about the entry.
+// Followed symbolic links are described by their targets.
+func (e *FSDirEntry) Info() (*FileInfo, error) {
+	if e.target != nil {
+		return NewFileInfo(e.target), nil
+	}
+	info, err := e.entry.Info()
+	if err != nil {
+		return nil, err
+	}
+	return NewFileInfo(info), nil
+}
+
+// A directory that is being read by `FSWalker`.
+type fsWalkerFrame struct {
+	dir     string
+	rel     string
+	depth   int
+	entries []fs.DirEntry
+	index   int
+}
+
+// Lazily walks a directory tree in lexical order.
+// Directories are descended into after they have been yielded
+// so that they can be skipped with `SkipDir`.
+// It is safe to use by multiple threads.
+type FSWalker struct {
+	Root     string
+	Symlinks SymlinkPolicy
+	ignore   []string
+	maxDepth int // negative when unlimited
+	m        sync.Mutex
+	started  bool
+	stack    []*fsWalkerFrame
+	pending  *FSDirEntry         // the last yielded directory
+	visited  map[string]struct{} // real paths of followed directories
+}
+
+// Create a new walker.
+// Entries whose paths relative to the root match any of the `ignore` patterns
+// are skipped along with their content.
+// Patterns without a slash are matched against the names of entries.
+func NewFSWalker(root string, symlinks SymlinkPolicy, ignore []string) (*FSWalker, error) {
+	for _, pattern := range ignore {
+		if !doublestar.ValidatePattern(pattern) {
+			return nil, fmt.Errorf("invalid glob pattern: %q", pattern)
+		}
+	}
+
+	return &FSWalker{
+		Root:     root,
+		Symlinks: symlinks,
+		ignore:   ignore,
+		maxDepth: -1,
+	}, nil
+}
+
+func (*FSWalker) Class() *Class {
+	return FSWalkerClass
+}
+
+func (*FSWalker) DirectClass() *Class {
+	return FSWalkerClass
+}
+
+func (*FSWalker) SingletonClass() *Class {
+	return nil
+}
+
+func (w *FSWalker) Copy() Reference {
+	return w
+}
+
+func (w *FSWalker) ToValue() Value {
+	return Ref(w)
+}
+
+func (w *FSWalker) Inspect() string {
+	return fmt.Sprintf(
+		"Std::FS::Walker{&: %p, root: %s, symlinks: :%s}",
+		w,
+		String(w.Root).Inspect(),
+		w.Symlinks,
+	)
+}
+
+func (w *FSWalker) Error() string {
+	return w.Inspect()
+}
+
+func (*FSWalker) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Do not descend into the last yielded directory.
+func (w *FSWalker) SkipDir() {
+	w.m.Lock()
+	w.pending = nil
+	w.m.Unlock()
+}
+
+func (w *FSWalker) NextValue() (Value, Value) {
+	entry, ok, err := w.Next()
+	if err != nil {
+		return Undefined, NewFSError(err)
+	}
+	if !ok {
+		return Undefined, stopIterationSymbol.ToValue()
+	}
+	return Ref(entry), Undefined
+}
+
+// Returns the next entry of the tree.
+// Returns false when there are no more entries.
+// A directory that cannot be read is reported as an error
+// and the walk can be continued.
+func (w *FSWalker) Next() (*FSDirEntry, bool, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if !w.started {
+		w.started = true
+		if err := w.push(w.Root, ".", 0); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if pending := w.pending; pending != nil {
+		w.pending = nil
+		if err := w.push(pending.Path, pending.rel, pending.Depth); err != nil {
+			return nil, false, err
+		}
+	}
+
+	for len(w.stack) > 0 {
+		frame := w.stack[len(w.stack)-1]
+		if frame.index >= len(frame.entries) {
+			w.stack = w.stack[:len(w.stack)-1]
+			continue
+		}
+
+		dirEntry := frame.entries[frame.index]
+		frame.index++
+		entry := &FSDirEntry{
+			Path:  filepath.Join(frame.dir, dirEntry.Name()),
+			Depth: frame.depth + 1,
+			rel:   path.Join(frame.rel, dirEntry.Name()),
+			entry: dirEntry,
+		}
+		if w.isIgnored(entry) {
+			continue
+		}
+
+		if entry.IsSymlink() {
+			switch w.Symlinks {
+			case SymlinkSkip:
+				continue
+			case SymlinkFollow:
+				// broken links are yielded as they are
+				if target, err := os.Stat(entry.Path); err == nil {
+					entry.target = target
+				}
+			}
+		}
+
+		if entry.IsDir() && (w.maxDepth < 0 || entry.Depth < w.maxDepth) {
+			w.pending = entry
+		}
+		return entry, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// Start reading a directory.
+func (w *FSWalker) push(dir, rel string, depth int) error {
+	if w.Symlinks == SymlinkFollow {
+		// guard against cycles of symbolic links
+		realPath, err := filepath.EvalSymlinks(dir)
+		if err != nil {
+			return err
+		}
+		if w.visited == nil {
+			w.visited = make(map[string]struct{})
+		}
+		if _, ok := w.visited[realPath]; ok {
+			return nil
+		}
+		w.visited[realPath] = struct{}{}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	w.stack = append(w.stack, &fsWalkerFrame{
+		dir:     dir,
+		rel:     rel,
+		depth:   depth,
+		entries: entries,
+	})
+	return nil
+}
+
+func (w *FSWalker) isIgnored(entry *FSDirEntry) bool {
+	for _, pattern := range w.ignore {
+		name := entry.rel
+		if !strings.Contains(pattern, "/") {
+			name = entry.Name()
+		}
+		if doublestar.MatchUnvalidated(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Lazily yields the paths that match a glob pattern.
+// It is safe to use by multiple threads.
+type FSGlobIterator struct {
+	Pattern string
+	base    string
+	rest    string
+	walker  *FSWalker
+}
+
+// Create a new glob iterator.
+// The pattern is slash-separated and supports the syntax of `doublestar`.
+func NewFSGlobIterator(pattern string, symlinks SymlinkPolicy, ignore []string) (*FSGlobIterator, error) {
+	if !doublestar.ValidatePattern(pattern) {
+		return nil, fmt.Errorf("invalid glob pattern: %q", pattern)
+	}
+
+	base, rest := doublestar.SplitPattern(pattern)
+	walker, err := NewFSWalker(filepath.FromSlash(base), symlinks, ignore)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(rest, "**") {
+		walker.maxDepth = strings.Count(rest, "/") + 1
+	}
+
+	return &FSGlobIterator{
+		Pattern: pattern,
+		base:    base,
+		rest:    rest,
+		walker:  walker,
+	}, nil
+}
+
+func (*FSGlobIterator) Class() *Class {
+	return FSGlobIteratorClass
+}
+
+func (*FSGlobIterator) DirectClass() *Class {
+	return FSGlobIteratorClass
+}
+
+func (*FSGlobIterator) SingletonClass() *Class {
+	return nil
+}
+
+func (g *FSGlobIterator) Copy() Reference {
+	return g
+}
+
+func (g *FSGlobIterator) ToValue() Value {
+	return Ref(g)
+}
+
+func (g *FSGlobIterator) Inspect() string {
+	return fmt.Sprintf("Std::FS::GlobIterator{&: %p, pattern: %s}", g, String(g.Pattern).Inspect())
+}
+
+func (g *FSGlobIterator) Error() string {
+	return g.Inspect()
+}
+
+func (*FSGlobIterator) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (g *FSGlobIterator) NextValue() (Value, Value) {
+	path, ok := g.Next()
+	if !ok {
+		return Undefined, stopIterationSymbol.ToValue()
+	}
+	return Ref(NewPath(path)), Undefined
+}
+
+// Returns the next matching path.
+// Returns false when there are no more paths.
+// Directories that cannot be read are ignored.
+func (g *FSGlobIterator) Next() (string, bool) {
+	for {
+		entry, ok, err := g.walker.Next()
+		if err != nil {
+			continue
+		}
+		if !ok {
+			return "", false
+		}
+
+		if doublestar.MatchUnvalidated(g.rest, entry.rel) {
+			return entry.Path, true
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package value_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/elk-language/elk/value"
+	"github.com/google/go-cmp/cmp"
+)
+
+func walkFSTree(t *testing.T, walker *value.FSWalker) []string {
+	t.Helper()
+
+	var paths []string
+	for {
+		entry, ok, err := walker.Next()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !ok {
+			return paths
+		}
+		rel, _ := filepath.Rel(walker.Root, entry.Path)
+		if entry.IsSymlink() {
+			rel += "@"
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+	}
+}
+
+func TestFSWalkerSymlinks(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "dir", "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "dir", "sub", "file.txt"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(root, "dir", "sub"), filepath.Join(root, "link")); err != nil {
+		t.Skipf("symbolic links are not supported: %s", err)
+	}
+	// a cycle
+	if err := os.Symlink(filepath.Join(root, "dir"), filepath.Join(root, "dir", "sub", "parent")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		policy value.SymlinkPolicy
+		want   []string
+	}{
+		"no_follow": {
+			policy: value.SymlinkNoFollow,
+			want: []string{
+				"dir",
+				"dir/sub",
+				"dir/sub/file.txt",
+				"dir/sub/parent@",
+				"link@",
+			},
+		},
+		"follow": {
+			policy: value.SymlinkFollow,
+			want: []string{
+				"dir",
+				"dir/sub",
+				"dir/sub/file.txt",
+				"dir/sub/parent@",
+				"link@",
+			},
+		},
+		"skip": {
+			policy: value.SymlinkSkip,
+			want: []string{
+				"dir",
+				"dir/sub",
+				"dir/sub/file.txt",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			walker, err := value.NewFSWalker(root, tc.policy, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := walkFSTree(t, walker)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestFSWalkerFollowSymlinks(t *testing.T) {
+	root := t.TempDir()
+	target := t.TempDir()
+	if err := os.WriteFile(filepath.Join(target, "file.txt"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, filepath.Join(root, "link")); err != nil {
+		t.Skipf("symbolic links are not supported: %s", err)
+	}
+
+	walker, err := value.NewFSWalker(root, value.SymlinkFollow, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := walkFSTree(t, walker)
+	want := []string{
+		"link@",
+		"link/file.txt",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -65,6 +65,7 @@ func InitGlobalEnvironment() {
 	initFS()
 	initPath()
 	initError()
+	initFSErrors()
 	initInterface()
 	initAborter()
 	initKernel()
@@ -134,14 +135,26 @@ func InitGlobalEnvironment() {
 	initLockable()
 	initSync()
 	initWaitGroup()
+	initTaskGroup()
 	initMutex()
 	initRWMutex()
 	initROMutex()
 	initOnce()
+	initSemaphore()
+	initCond()
+	initBarrier()
+	initCountDownLatch()
+	initAtomicInt()
+	initAtomicUInt64()
+	initAtomicBool()
+	initAtomicRef()
+	initSyncMap()
+	initSyncQueue()
 	initStackTrace()
 	initCallFrame()
 	initPromise()
 	initThreadPool()
+	initActor()
 	initChannel()
 	initReadChannel()
 	initWriteChannel()
@@ -157,12 +170,20 @@ func InitGlobalEnvironment() {
 	initElkParser()
 	initElkType()
 	initLocation()
+	initFile()
+	initFileInfo()
+	initFSWalker()
+	initIO()
+	initOS()
+	initProcess()
 	initDuration()
 	initDate()
 	initDateSpan()
 	initTime()
 	initTimeSpan()
 	initDateTime()
+	initTimer()
+	initTicker()
 	initDateTimeSpan()
 	initTimezone()
 }
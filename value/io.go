@@ -0,0 +1,273 @@
+package value
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+var IOModule *Module             // ::Std::IO
+var IOErrorClass *Class          // ::Std::IO::Error
+var IOReaderInterface *Interface // ::Std::IO::Reader
+var IOWriterInterface *Interface // ::Std::IO::Writer
+var IOBufferedReaderClass *Class // ::Std::IO::BufferedReader
+var IOBufferedWriterClass *Class // ::Std::IO::BufferedWriter
+var IOStringReaderClass *Class   // ::Std::IO::StringReader
+var IOStringWriterClass *Class   // ::Std::IO::StringWriter
+var IOStandardWriterClass *Class // ::Std::IO::StandardWriter
+
+func initIO() {
+	IOModule = NewModule()
+	StdModule.AddConstantString("IO", Ref(IOModule))
+	RegisterNativeModule("Std::IO", "value.IOModule")
+
+	IOErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	IOModule.AddConstantString("Error", Ref(IOErrorClass))
+	RegisterNativeClass("Std::IO::Error", "value.IOErrorClass")
+
+	IOReaderInterface = NewInterface()
+	IOModule.AddConstantString("Reader", Ref(IOReaderInterface))
+	RegisterNativeInterface("Std::IO::Reader", "value.IOReaderInterface")
+
+	IOWriterInterface = NewInterface()
+	IOModule.AddConstantString("Writer", Ref(IOWriterInterface))
+	RegisterNativeInterface("Std::IO::Writer", "value.IOWriterInterface")
+
+	IOBufferedReaderClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	IOModule.AddConstantString("BufferedReader", Ref(IOBufferedReaderClass))
+	RegisterNativeClass("Std::IO::BufferedReader", "value.IOBufferedReaderClass")
+
+	IOBufferedWriterClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	IOModule.AddConstantString("BufferedWriter", Ref(IOBufferedWriterClass))
+	RegisterNativeClass("Std::IO::BufferedWriter", "value.IOBufferedWriterClass")
+
+	IOStringReaderClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	IOModule.AddConstantString("StringReader", Ref(IOStringReaderClass))
+	RegisterNativeClass("Std::IO::StringReader", "value.IOStringReaderClass")
+
+	IOStringWriterClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	IOModule.AddConstantString("StringWriter", Ref(IOStringWriterClass))
+	RegisterNativeClass("Std::IO::StringWriter", "value.IOStringWriterClass")
+
+	IOStandardWriterClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	IOModule.AddConstantString("StandardWriter", Ref(IOStandardWriterClass))
+	RegisterNativeClass("Std::IO::StandardWriter", "value.IOStandardWriterClass")
+}
+
+// Read up to `limit` bytes from a reader.
+// Returns an empty string when the reader is exhausted.
+// A negative limit reads everything.
+func ReadString(reader io.Reader, limit int) (string, error) {
+	if limit < 0 {
+		content, err := io.ReadAll(reader)
+		return string(content), err
+	}
+	if limit == 0 {
+		return "", nil
+	}
+
+	buff := make([]byte, limit)
+	for {
+		n, err := reader.Read(buff)
+		if n > 0 {
+			return string(buff[:n]), nil
+		}
+		if err == io.EOF {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// Read the next line without the trailing line break.
+// Returns false when there are no more lines to read.
+func ReadLine(reader *bufio.Reader) (string, bool, error) {
+	line, err := reader.ReadString('\n')
+	if err == io.EOF {
+		if len(line) == 0 {
+			return "", false, nil
+		}
+		err = nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+	line = strings.TrimSuffix(line, "\r")
+	return line, true, nil
+}
+
+// An in-memory reader of a string.
+// It is safe to use by multiple threads.
+type StringReader struct {
+	m      sync.Mutex
+	reader strings.Reader
+}
+
+func NewStringReader(content string) *StringReader {
+	r := &StringReader{}
+	r.reader.Reset(content)
+	return r
+}
+
+func (*StringReader) Class() *Class {
+	return IOStringReaderClass
+}
+
+func (*StringReader) DirectClass() *Class {
+	return IOStringReaderClass
+}
+
+func (*StringReader) SingletonClass() *Class {
+	return nil
+}
+
+func (r *StringReader) Copy() Reference {
+	return r
+}
+
+func (r *StringReader) ToValue() Value {
+	return Ref(r)
+}
+
+func (r *StringReader) Inspect() string {
+	return fmt.Sprintf("Std::IO::StringReader{&: %p, length: %d}", r, r.Length())
+}
+
+func (r *StringReader) Error() string {
+	return r.Inspect()
+}
+
+func (*StringReader) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the number of unread bytes.
+func (r *StringReader) Length() int {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return r.reader.Len()
+}
+
+func (r *StringReader) Read(p []byte) (int, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return r.reader.Read(p)
+}
+
+// An in-memory writer that builds a string.
+// It is safe to use by multiple threads.
+type StringWriter struct {
+	m       sync.Mutex
+	builder strings.Builder
+}
+
+func NewStringWriter() *StringWriter {
+	return &StringWriter{}
+}
+
+func (*StringWriter) Class() *Class {
+	return IOStringWriterClass
+}
+
+func (*StringWriter) DirectClass() *Class {
+	return IOStringWriterClass
+}
+
+func (*StringWriter) SingletonClass() *Class {
+	return nil
+}
+
+func (w *StringWriter) Copy() Reference {
+	return w
+}
+
+func (w *StringWriter) ToValue() Value {
+	return Ref(w)
+}
+
+func (w *StringWriter) Inspect() string {
+	return fmt.Sprintf("Std::IO::StringWriter{&: %p, length: %d}", w, w.Length())
+}
+
+func (w *StringWriter) Error() string {
+	return w.Inspect()
+}
+
+func (*StringWriter) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the number of written bytes.
+func (w *StringWriter) Length() int {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return w.builder.Len()
+}
+
+func (w *StringWriter) String() string {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return w.builder.String()
+}
+
+func (w *StringWriter) Write(p []byte) (int, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return w.builder.Write(p)
+}
+
+// Standard output and standard error of the current thread.
+var IOStdout = &StandardWriter{}
+var IOStderr = &StandardWriter{Stderr: true}
+
+// Writes to the standard output or standard error
+// of the thread that calls `write`.
+type StandardWriter struct {
+	Stderr bool
+}
+
+func (*StandardWriter) Class() *Class {
+	return IOStandardWriterClass
+}
+
+func (*StandardWriter) DirectClass() *Class {
+	return IOStandardWriterClass
+}
+
+func (*StandardWriter) SingletonClass() *Class {
+	return nil
+}
+
+func (w *StandardWriter) Copy() Reference {
+	return w
+}
+
+func (w *StandardWriter) ToValue() Value {
+	return Ref(w)
+}
+
+func (w *StandardWriter) Inspect() string {
+	if w.Stderr {
+		return "Std::IO::StandardWriter{stderr}"
+	}
+	return "Std::IO::StandardWriter{stdout}"
+}
+
+func (w *StandardWriter) Error() string {
+	return w.Inspect()
+}
+
+func (*StandardWriter) InstanceVariables() *InstanceVariables {
+	return nil
+}
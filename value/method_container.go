@@ -1,10 +1,33 @@
 package value
 
+import "sync"
+
 type MethodContainer struct {
 	Methods MethodMap
 	Parent  *Class
 }
 
+// When true, method lookups and definitions are synchronised
+// so that methods can be safely redefined while
+// the program is running (like during hot reloading).
+//
+// Should be set before any code gets executed.
+var GuardMethodTables bool
+
+// Guards the method tables of all classes when `GuardMethodTables` is true.
+var methodTablesMutex sync.RWMutex
+
+// Lock the method tables of all classes for writing.
+// Method lookups block until `UnlockMethodTables` gets called.
+func LockMethodTables() {
+	methodTablesMutex.Lock()
+}
+
+// Unlock the method tables locked with `LockMethodTables`.
+func UnlockMethodTables() {
+	methodTablesMutex.Unlock()
+}
+
 // Get the superclass (skipping any mixin proxies)
 func (m *MethodContainer) Superclass() *Class {
 	currentClass := m.Parent
@@ -20,6 +43,11 @@ func (m *MethodContainer) Superclass() *Class {
 // Search for a method with the given name in
 // this container and its ancestors.
 func (m *MethodContainer) LookupMethod(name Symbol) Method {
+	if GuardMethodTables {
+		methodTablesMutex.RLock()
+		defer methodTablesMutex.RUnlock()
+	}
+
 	if method, ok := m.Methods[name]; ok {
 		return method
 	}
@@ -35,6 +63,11 @@ func (m *MethodContainer) LookupMethod(name Symbol) Method {
 
 // Attaches the given method under the given name.
 func (m *MethodContainer) AttachMethod(name Symbol, method Method) {
+	if GuardMethodTables {
+		methodTablesMutex.Lock()
+		defer methodTablesMutex.Unlock()
+	}
+
 	m.Methods[name] = method
 }
 
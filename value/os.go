@@ -0,0 +1,57 @@
+package value
+
+var OSModule *Module          // ::Std::OS
+var OSErrorClass *Class       // ::Std::OS::Error
+var OSEnvironmentClass *Class // ::Std::OS::Environment
+
+func initOS() {
+	OSModule = NewModule()
+	StdModule.AddConstantString("OS", Ref(OSModule))
+	RegisterNativeModule("Std::OS", "value.OSModule")
+
+	OSErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	OSModule.AddConstantString("Error", Ref(OSErrorClass))
+	RegisterNativeClass("Std::OS::Error", "value.OSErrorClass")
+
+	OSEnvironmentClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	OSModule.AddConstantString("Environment", Ref(OSEnvironmentClass))
+	RegisterNativeClass("Std::OS::Environment", "value.OSEnvironmentClass")
+}
+
+// The environment variables of the process.
+var OSEnv = &Environment{}
+
+// Provides access to the environment variables of the process.
+type Environment struct{}
+
+func (*Environment) Class() *Class {
+	return OSEnvironmentClass
+}
+
+func (*Environment) DirectClass() *Class {
+	return OSEnvironmentClass
+}
+
+func (*Environment) SingletonClass() *Class {
+	return nil
+}
+
+func (e *Environment) Copy() Reference {
+	return e
+}
+
+func (e *Environment) ToValue() Value {
+	return Ref(e)
+}
+
+func (*Environment) Inspect() string {
+	return "Std::OS::Environment{}"
+}
+
+func (e *Environment) Error() string {
+	return e.Inspect()
+}
+
+func (*Environment) InstanceVariables() *InstanceVariables {
+	return nil
+}
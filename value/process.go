@@ -0,0 +1,385 @@
+package value
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+var ProcessClass *Class           // ::Std::Process
+var ProcessErrorClass *Class      // ::Std::Process::Error
+var ProcessResultClass *Class     // ::Std::Process::Result
+var ProcessPipeReaderClass *Class // ::Std::Process::PipeReader
+var ProcessPipeWriterClass *Class // ::Std::Process::PipeWriter
+
+func initProcess() {
+	ProcessClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	StdModule.AddConstantString("Process", Ref(ProcessClass))
+	RegisterNativeClass("Std::Process", "value.ProcessClass")
+
+	ProcessErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	ProcessClass.AddConstantString("Error", Ref(ProcessErrorClass))
+	RegisterNativeClass("Std::Process::Error", "value.ProcessErrorClass")
+
+	ProcessResultClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	ProcessClass.AddConstantString("Result", Ref(ProcessResultClass))
+	RegisterNativeClass("Std::Process::Result", "value.ProcessResultClass")
+
+	ProcessPipeReaderClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	ProcessClass.AddConstantString("PipeReader", Ref(ProcessPipeReaderClass))
+	RegisterNativeClass("Std::Process::PipeReader", "value.ProcessPipeReaderClass")
+
+	ProcessPipeWriterClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	ProcessClass.AddConstantString("PipeWriter", Ref(ProcessPipeWriterClass))
+	RegisterNativeClass("Std::Process::PipeWriter", "value.ProcessPipeWriterClass")
+}
+
+// Create a new `Std::Process::Error`.
+func NewProcessError(err error) Value {
+	return Ref(NewError(ProcessErrorClass, err.Error()))
+}
+
+// A subprocess started by `Process.spawn`.
+type Process struct {
+	Command string
+	Stdin   *PipeWriter
+	Stdout  *PipeReader
+	Stderr  *PipeReader
+	cmd     *exec.Cmd
+
+	m        sync.Mutex
+	exited   chan struct{} // closed when the process has exited
+	state    *os.ProcessState
+	waitErr  error
+	onExit   []func()    // called when the process exits
+	stopKill func() bool // stops killing the process when the context is done
+}
+
+// Start a subprocess with pipes connected to
+// its standard input, output and error.
+// The process gets killed when the context is done.
+func StartProcess(ctx context.Context, cmd *exec.Cmd) (*Process, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	p := &Process{
+		Command: cmd.Path,
+		Stdin:   &PipeWriter{native: stdin},
+		Stdout:  &PipeReader{native: stdout},
+		Stderr:  &PipeReader{native: stderr},
+		cmd:     cmd,
+		exited:  make(chan struct{}),
+	}
+	p.stopKill = context.AfterFunc(ctx, func() {
+		p.cmd.Process.Kill()
+	})
+	go p.reap()
+	return p, nil
+}
+
+// Wait for the process to exit and release its resources.
+// Unlike `exec.Cmd.Wait` it does not close the pipes,
+// so that the output can still be read.
+func (p *Process) reap() {
+	state, err := p.cmd.Process.Wait()
+	p.stopKill()
+
+	p.m.Lock()
+	p.state = state
+	p.waitErr = err
+	close(p.exited)
+	onExit := p.onExit
+	p.onExit = nil
+	p.m.Unlock()
+
+	for _, fn := range onExit {
+		fn()
+	}
+}
+
+func (*Process) Class() *Class {
+	return ProcessClass
+}
+
+func (*Process) DirectClass() *Class {
+	return ProcessClass
+}
+
+func (*Process) SingletonClass() *Class {
+	return nil
+}
+
+func (p *Process) Copy() Reference {
+	return p
+}
+
+func (p *Process) ToValue() Value {
+	return Ref(p)
+}
+
+func (p *Process) Inspect() string {
+	return fmt.Sprintf("Std::Process{command: %s, pid: %d}", String(p.Command).Inspect(), p.Pid())
+}
+
+func (p *Process) Error() string {
+	return p.Inspect()
+}
+
+func (*Process) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the ID of the process.
+func (p *Process) Pid() int {
+	return p.cmd.Process.Pid
+}
+
+// Returns a channel that gets closed
+// when the process has exited.
+func (p *Process) Exited() <-chan struct{} {
+	return p.exited
+}
+
+// Call the function when the process exits
+// or immediately when it has already exited.
+// The function should not block.
+func (p *Process) OnExit(fn func()) {
+	p.m.Lock()
+	select {
+	case <-p.exited:
+		p.m.Unlock()
+		fn()
+		return
+	default:
+	}
+	p.onExit = append(p.onExit, fn)
+	p.m.Unlock()
+}
+
+// Returns the exit code of a process that has exited.
+// The exit code is -1 when the process has been terminated by a signal.
+func (p *Process) ExitCode() (int, error) {
+	<-p.exited
+	if p.waitErr != nil {
+		return -1, p.waitErr
+	}
+	return p.state.ExitCode(), nil
+}
+
+// Wait for the process to exit and return its exit code.
+// The exit code is -1 when the process has been terminated by a signal.
+// Pipes of the process get closed, so they should be read beforehand.
+func (p *Process) Wait() (int, error) {
+	p.Stdin.Close()
+	code, err := p.ExitCode()
+	p.Stdout.Close()
+	p.Stderr.Close()
+	return code, err
+}
+
+// Close the pipes connected to the standard input, output and error of the process.
+func (p *Process) Close() {
+	p.Stdin.Close()
+	p.Stdout.Close()
+	p.Stderr.Close()
+}
+
+// Send a signal to the process.
+func (p *Process) Signal(sig os.Signal) error {
+	err := p.cmd.Process.Signal(sig)
+	if errors.Is(err, os.ErrProcessDone) {
+		return nil
+	}
+	return err
+}
+
+// The result of a process executed by `Process.run`.
+type ProcessResult struct {
+	Status int
+	Stdout string
+	Stderr string
+}
+
+func (*ProcessResult) Class() *Class {
+	return ProcessResultClass
+}
+
+func (*ProcessResult) DirectClass() *Class {
+	return ProcessResultClass
+}
+
+func (*ProcessResult) SingletonClass() *Class {
+	return nil
+}
+
+func (r *ProcessResult) Copy() Reference {
+	return r
+}
+
+func (r *ProcessResult) ToValue() Value {
+	return Ref(r)
+}
+
+func (r *ProcessResult) Inspect() string {
+	return fmt.Sprintf(
+		"Std::Process::Result{status: %d, stdout: %s, stderr: %s}",
+		r.Status,
+		String(r.Stdout).Inspect(),
+		String(r.Stderr).Inspect(),
+	)
+}
+
+func (r *ProcessResult) Error() string {
+	return r.Inspect()
+}
+
+func (*ProcessResult) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Whether the process exited with status 0.
+func (r *ProcessResult) IsSuccess() bool {
+	return r.Status == 0
+}
+
+// Reads the standard output or standard error of a subprocess.
+type PipeReader struct {
+	native io.ReadCloser
+}
+
+func (*PipeReader) Class() *Class {
+	return ProcessPipeReaderClass
+}
+
+func (*PipeReader) DirectClass() *Class {
+	return ProcessPipeReaderClass
+}
+
+func (*PipeReader) SingletonClass() *Class {
+	return nil
+}
+
+func (r *PipeReader) Copy() Reference {
+	return r
+}
+
+func (r *PipeReader) ToValue() Value {
+	return Ref(r)
+}
+
+func (r *PipeReader) Inspect() string {
+	return fmt.Sprintf("Std::Process::PipeReader{&: %p}", r)
+}
+
+func (r *PipeReader) Error() string {
+	return r.Inspect()
+}
+
+func (*PipeReader) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (r *PipeReader) Read(p []byte) (int, error) {
+	return r.native.Read(p)
+}
+
+// Close the pipe.
+// Closing an already closed pipe is a no-op.
+func (r *PipeReader) Close() error {
+	err := r.native.Close()
+	if errors.Is(err, os.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// Writes to the standard input of a subprocess.
+type PipeWriter struct {
+	native io.WriteCloser
+}
+
+func (*PipeWriter) Class() *Class {
+	return ProcessPipeWriterClass
+}
+
+func (*PipeWriter) DirectClass() *Class {
+	return ProcessPipeWriterClass
+}
+
+func (*PipeWriter) SingletonClass() *Class {
+	return nil
+}
+
+func (w *PipeWriter) Copy() Reference {
+	return w
+}
+
+func (w *PipeWriter) ToValue() Value {
+	return Ref(w)
+}
+
+func (w *PipeWriter) Inspect() string {
+	return fmt.Sprintf("Std::Process::PipeWriter{&: %p}", w)
+}
+
+func (w *PipeWriter) Error() string {
+	return w.Inspect()
+}
+
+func (*PipeWriter) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (w *PipeWriter) Write(p []byte) (int, error) {
+	return w.native.Write(p)
+}
+
+// Close the pipe signalling the end of input to the process.
+// Closing an already closed pipe is a no-op.
+func (w *PipeWriter) Close() error {
+	err := w.native.Close()
+	if errors.Is(err, os.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// Build the environment of a subprocess
+// from the current environment and additional variables.
+func ProcessEnviron(extra map[string]string) []string {
+	environ := os.Environ()
+	if len(extra) == 0 {
+		return environ
+	}
+
+	result := make([]string, 0, len(environ)+len(extra))
+	for _, entry := range environ {
+		name, _, _ := strings.Cut(entry, "=")
+		if _, ok := extra[name]; ok {
+			continue
+		}
+		result = append(result, entry)
+	}
+	for name, val := range extra {
+		result = append(result, name+"="+val)
+	}
+	return result
+}
@@ -1,9 +1,25 @@
 package value
 
-var PromiseClass *Class // ::Std::Promise
+var PromiseClass *Class               // ::Std::Promise
+var PromiseTimeoutErrorClass *Class   // ::Std::Promise::TimeoutError
+var PromiseAggregateErrorClass *Class // ::Std::Promise::AggregateError
 
 func initPromise() {
 	PromiseClass = NewClass()
 	StdModule.AddConstantString("Promise", Ref(PromiseClass))
 	RegisterNativeClass("Std::Promise", "value.PromiseClass")
+
+	PromiseTimeoutErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	PromiseClass.AddConstantString("TimeoutError", Ref(PromiseTimeoutErrorClass))
+	RegisterNativeClass("Std::Promise::TimeoutError", "value.PromiseTimeoutErrorClass")
+
+	PromiseAggregateErrorClass = NewClassWithOptions(
+		ClassWithSuperclass(ErrorClass),
+		ClassWithIvarIndices(IvarIndices{
+			ToSymbol("message"): 0,
+			ToSymbol("errors"):  1,
+		}),
+	)
+	PromiseClass.AddConstantString("AggregateError", Ref(PromiseAggregateErrorClass))
+	RegisterNativeClass("Std::Promise::AggregateError", "value.PromiseAggregateErrorClass")
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/cespare/xxhash/v2"
 	"github.com/elk-language/elk/bitfield"
 	"github.com/elk-language/elk/regex"
+	"github.com/elk-language/elk/regex/backtrack"
 	"github.com/elk-language/elk/regex/flag"
+	"github.com/elk-language/elk/regex/parser"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -17,9 +19,12 @@ var RegexClass *Class // ::Std::Regex
 
 // Elk's compiled regex
 type Regex struct {
-	Re     regexp.Regexp
-	Source string
-	Flags  bitfield.BitField8
+	Re regexp.Regexp
+	// Used instead of `Re` when the regex relies on features
+	// that Go regexes do not support like lookarounds or backreferences.
+	Backtrack *backtrack.Program
+	Source    string
+	Flags     bitfield.BitField8
 }
 
 func NewRegex(re regexp.Regexp, src string, flags bitfield.BitField8) *Regex {
@@ -30,12 +35,35 @@ func NewRegex(re regexp.Regexp, src string, flags bitfield.BitField8) *Regex {
 	}
 }
 
+func NewBacktrackingRegex(program *backtrack.Program, src string, flags bitfield.BitField8) *Regex {
+	return &Regex{
+		Backtrack: program,
+		Source:    src,
+		Flags:     flags,
+	}
+}
+
+// Compile an Elk regex.
+// Uses the backtracking engine when the regex relies on
+// lookarounds, backreferences, atomic groups or possessive quantifiers.
 func CompileRegex(src string, flags bitfield.BitField8) (*Regex, error) {
-	goSrc, errList := regex.Transpile(src, flags)
+	node, errList := parser.Parse(src)
 	if errList != nil {
 		return nil, errList
 	}
 
+	goSrc, errList := regex.TranspileNode(node, flags)
+	if errList != nil {
+		if !backtrack.IsRequired(node) {
+			return nil, errList
+		}
+		program, errList := backtrack.CompileNode(node, flags)
+		if errList != nil {
+			return nil, errList
+		}
+		return NewBacktrackingRegex(program, src, flags), nil
+	}
+
 	goRe, err := regexp.Compile(goSrc)
 	if err != nil {
 		return nil, err
@@ -116,8 +144,18 @@ func (r *Regex) InstanceVariables() *InstanceVariables {
 	return nil
 }
 
-func (r *Regex) LaxEqual(other Value) Value {
-	return r.EqualVal(other)
+// Check whether r is equal to other.
+// When other is a `String` checks whether r matches it.
+// Returns an error when the search exceeds the step limit.
+func (r *Regex) LaxEqual(other Value) (Value, Value) {
+	if str, ok := other.SafeAsReference().(String); ok {
+		matched, err := r.MatchString(string(str))
+		if !err.IsUndefined() {
+			return Undefined, err
+		}
+		return BoolVal(matched), Undefined
+	}
+	return r.EqualVal(other), Undefined
 }
 
 // Check whether r is equal to other
@@ -138,9 +176,16 @@ func (r *Regex) Equal(other Value) bool {
 	}
 }
 
-// Check whether r is equal to other
+// Check whether r is equal to other.
+// When other is a `String` checks whether r matches it.
+// Returns undefined when r is executed by the backtracking engine
+// since the search may fail, use `LaxEqual` instead.
 func (r *Regex) LaxEqualVal(other Value) Value {
-	return r.EqualVal(other)
+	if _, ok := other.SafeAsReference().(String); ok && r.IsBacktracking() {
+		return Undefined
+	}
+	result, _ := r.LaxEqual(other)
+	return result
 }
 
 func (r *Regex) Hash() UInt64 {
@@ -211,15 +256,138 @@ func (r *Regex) Matches(other Value) (Value, Value) {
 	}
 	switch o := other.AsReference().(type) {
 	case String:
-		return Bool(r.Re.MatchString(string(o))).ToValue(), Undefined
+		matched, err := r.MatchString(string(o))
+		if !err.IsUndefined() {
+			return Undefined, err
+		}
+		return BoolVal(matched), Undefined
 	default:
 		return Undefined, Ref(NewCoerceError(r.Class(), other.Class()))
 	}
 }
 
-// Check whether the regex matches the given string
-func (r *Regex) MatchesString(other string) bool {
-	return r.Re.MatchString(other)
+// Whether the regex is executed by the backtracking engine.
+func (r *Regex) IsBacktracking() bool {
+	return r.Backtrack != nil
+}
+
+// Check whether the regex matches the given string.
+// Returns an error when the search exceeds the step limit.
+func (r *Regex) MatchString(subject string) (bool, Value) {
+	if r.Backtrack == nil {
+		return r.Re.MatchString(subject), Undefined
+	}
+
+	matched, err := r.Backtrack.MatchString(subject)
+	if err != nil {
+		return false, newRegexStepLimitError(r)
+	}
+	return matched, Undefined
+}
+
+// Returns the byte offsets of the leftmost match and its capture groups
+// or nil when there is no match.
+func (r *Regex) FindSubmatchIndex(subject string) ([]int, Value) {
+	if r.Backtrack == nil {
+		return r.Re.FindStringSubmatchIndex(subject), Undefined
+	}
+
+	indices, err := r.Backtrack.FindStringSubmatchIndex(subject)
+	if err != nil {
+		return nil, newRegexStepLimitError(r)
+	}
+	return indices, Undefined
+}
+
+// Returns the byte offsets of at most n successive matches
+// and their capture groups, -1 means no limit.
+func (r *Regex) FindAllSubmatchIndex(subject string, n int) ([][]int, Value) {
+	if r.Backtrack == nil {
+		return r.Re.FindAllStringSubmatchIndex(subject, n), Undefined
+	}
+
+	matches, err := r.Backtrack.FindAllStringSubmatchIndex(subject, n)
+	if err != nil {
+		return nil, newRegexStepLimitError(r)
+	}
+	return matches, Undefined
+}
+
+// Returns the names of capture groups,
+// the first element represents the entire match.
+func (r *Regex) SubexpNames() []string {
+	if r.Backtrack == nil {
+		return r.Re.SubexpNames()
+	}
+	return r.Backtrack.SubexpNames()
+}
+
+// Returns the index of the capture group with the given name
+// or -1 when there is no such group.
+func (r *Regex) SubexpIndex(name string) int {
+	if r.Backtrack == nil {
+		return r.Re.SubexpIndex(name)
+	}
+	return r.Backtrack.SubexpIndex(name)
+}
+
+// Append the template to dst with variables like `$1` or `${name}`
+// replaced by the capture groups of the match.
+func (r *Regex) ExpandString(dst []byte, template, src string, match []int) []byte {
+	if r.Backtrack == nil {
+		return r.Re.ExpandString(dst, template, src, match)
+	}
+	return r.Backtrack.ExpandString(dst, template, src, match)
+}
+
+// Split the string into substrings separated by matches of the regex.
+// `limit` is the maximum number of substrings,
+// all substrings are returned when it is negative.
+func (r *Regex) Split(subject string, limit int) ([]string, Value) {
+	if r.Backtrack == nil {
+		return r.Re.Split(subject, limit), Undefined
+	}
+
+	if limit == 0 {
+		return nil, Undefined
+	}
+	if len(r.Source) > 0 && len(subject) == 0 {
+		return []string{""}, Undefined
+	}
+
+	matches, err := r.FindAllSubmatchIndex(subject, limit)
+	if !err.IsUndefined() {
+		return nil, err
+	}
+	parts := make([]string, 0, len(matches))
+
+	var beg, end int
+	for _, match := range matches {
+		if limit > 0 && len(parts) == limit-1 {
+			break
+		}
+
+		end = match[0]
+		if match[1] != 0 {
+			parts = append(parts, subject[beg:end])
+		}
+		beg = match[1]
+	}
+
+	if end != len(subject) {
+		parts = append(parts, subject[beg:])
+	}
+
+	return parts, Undefined
+}
+
+func newRegexStepLimitError(r *Regex) Value {
+	return Ref(Errorf(
+		RegexStepLimitErrorClass,
+		"regex %s exceeded the step limit of %d, the pattern backtracks too much",
+		r.Inspect(),
+		r.Backtrack.StepLimit,
+	))
 }
 
 func NewRegexComparer(opts *cmp.Options) cmp.Option {
@@ -236,4 +404,12 @@ func initRegex() {
 	RegexClass = NewClass()
 	StdModule.AddConstantString("Regex", Ref(RegexClass))
 	RegisterNativeClass("Std::Regex", "value.RegexClass")
+
+	RegexMatchClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	RegexClass.AddConstantString("Match", Ref(RegexMatchClass))
+	RegisterNativeClass("Std::Regex::Match", "value.RegexMatchClass")
+
+	RegexMatchIteratorClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	RegexClass.AddConstantString("MatchIterator", Ref(RegexMatchIteratorClass))
+	RegisterNativeClass("Std::Regex::MatchIterator", "value.RegexMatchIteratorClass")
 }
@@ -0,0 +1,313 @@
+package value
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var RegexMatchClass *Class         // ::Std::Regex::Match
+var RegexMatchIteratorClass *Class // ::Std::Regex::MatchIterator
+
+// A single match of a regex in a string.
+type RegexMatch struct {
+	Regex   *Regex
+	Subject string
+	// Byte offsets of the beginning and end of every capture group,
+	// the first pair represents the entire match.
+	// Both offsets are -1 when the group did not participate in the match.
+	Indices []int
+}
+
+func NewRegexMatch(re *Regex, subject string, indices []int) *RegexMatch {
+	return &RegexMatch{
+		Regex:   re,
+		Subject: subject,
+		Indices: indices,
+	}
+}
+
+func (*RegexMatch) Class() *Class {
+	return RegexMatchClass
+}
+
+func (*RegexMatch) DirectClass() *Class {
+	return RegexMatchClass
+}
+
+func (*RegexMatch) SingletonClass() *Class {
+	return nil
+}
+
+func (m *RegexMatch) Copy() Reference {
+	return m
+}
+
+func (m *RegexMatch) ToValue() Value {
+	return Ref(m)
+}
+
+func (m *RegexMatch) Inspect() string {
+	return fmt.Sprintf(
+		"Std::Regex::Match{regex: %s, value: %s, byte_start: %d}",
+		m.Regex.Inspect(),
+		String(m.String()).Inspect(),
+		m.Indices[0],
+	)
+}
+
+func (m *RegexMatch) Error() string {
+	return m.Inspect()
+}
+
+func (*RegexMatch) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the entire matched text.
+func (m *RegexMatch) String() string {
+	return m.Subject[m.Indices[0]:m.Indices[1]]
+}
+
+// Returns the number of capture groups of the regex.
+func (m *RegexMatch) GroupCount() int {
+	return len(m.Indices)/2 - 1
+}
+
+// Returns the index of a capture group
+// identified by an `Int` index or a `String` or `Symbol` name.
+func (m *RegexMatch) GroupIndex(group Value) (int, Value) {
+	if group.IsUndefined() {
+		return 0, Undefined
+	}
+
+	var name string
+	if group.IsReference() {
+		switch g := group.AsReference().(type) {
+		case String:
+			name = string(g)
+		default:
+			return 0, Ref(Errorf(TypeErrorClass, "invalid capture group: %s", group.Inspect()))
+		}
+	} else {
+		switch group.ValueFlag() {
+		case SMALL_INT_FLAG:
+			i, err := NormalizeArrayIndex(int(group.AsSmallInt()), m.GroupCount()+1)
+			if !err.IsUndefined() {
+				return 0, err
+			}
+			return i, Undefined
+		case SYMBOL_FLAG:
+			name = group.AsInlineSymbol().String()
+		default:
+			return 0, Ref(Errorf(TypeErrorClass, "invalid capture group: %s", group.Inspect()))
+		}
+	}
+
+	i := m.Regex.SubexpIndex(name)
+	if i == -1 {
+		return 0, Ref(Errorf(IndexErrorClass, "undefined capture group: %s", group.Inspect()))
+	}
+	return i, Undefined
+}
+
+// Returns the text of the capture group with the given index.
+// Returns false when the group did not participate in the match.
+func (m *RegexMatch) Group(i int) (string, bool) {
+	start := m.Indices[2*i]
+	if start == -1 {
+		return "", false
+	}
+	return m.Subject[start:m.Indices[2*i+1]], true
+}
+
+// Returns the text of a capture group as a `String`
+// or `nil` when the group did not participate in the match.
+func (m *RegexMatch) GroupVal(group Value) (Value, Value) {
+	i, err := m.GroupIndex(group)
+	if !err.IsUndefined() {
+		return Undefined, err
+	}
+	str, ok := m.Group(i)
+	if !ok {
+		return Nil, Undefined
+	}
+	return Ref(String(str)), Undefined
+}
+
+// Returns the byte offsets of a capture group.
+// Returns false when the group did not participate in the match.
+func (m *RegexMatch) ByteBounds(group Value) (start, end int, ok bool, err Value) {
+	i, err := m.GroupIndex(group)
+	if !err.IsUndefined() {
+		return 0, 0, false, err
+	}
+	start = m.Indices[2*i]
+	if start == -1 {
+		return 0, 0, false, Undefined
+	}
+	return start, m.Indices[2*i+1], true, Undefined
+}
+
+// Returns the char offsets of a capture group.
+// Returns false when the group did not participate in the match.
+func (m *RegexMatch) CharBounds(group Value) (start, end int, ok bool, err Value) {
+	byteStart, byteEnd, ok, err := m.ByteBounds(group)
+	if !ok {
+		return 0, 0, ok, err
+	}
+	start = utf8.RuneCountInString(m.Subject[:byteStart])
+	end = start + utf8.RuneCountInString(m.Subject[byteStart:byteEnd])
+	return start, end, true, Undefined
+}
+
+// Returns the text before the match.
+func (m *RegexMatch) Before() string {
+	return m.Subject[:m.Indices[0]]
+}
+
+// Returns the text after the match.
+func (m *RegexMatch) After() string {
+	return m.Subject[m.Indices[1]:]
+}
+
+// Returns a list of the texts of all capture groups
+// (without the entire match).
+// Groups that did not participate in the match are represented by `nil`.
+func (m *RegexMatch) Captures() *ArrayListOfValue {
+	count := m.GroupCount()
+	result := NewArrayListOfValue(count)
+	for i := 1; i <= count; i++ {
+		str, ok := m.Group(i)
+		if !ok {
+			result.Append(Nil)
+			continue
+		}
+		result.Append(Ref(String(str)))
+	}
+	return result
+}
+
+// Expand a replacement template like `$1` or `${name}`
+// using the capture groups of the match.
+func (m *RegexMatch) Expand(template string) string {
+	return string(m.Regex.ExpandString(nil, template, m.Subject, m.Indices))
+}
+
+// Iterates over successive matches of a regex in a string.
+type RegexMatchIterator struct {
+	Regex   *Regex
+	Subject string
+	Matches [][]int
+	Index   int
+}
+
+var _ NativeResettableIterator = &RegexMatchIterator{}
+
+func NewRegexMatchIterator(re *Regex, subject string) (*RegexMatchIterator, Value) {
+	matches, err := re.FindAllSubmatchIndex(subject, -1)
+	if !err.IsUndefined() {
+		return nil, err
+	}
+	return &RegexMatchIterator{
+		Regex:   re,
+		Subject: subject,
+		Matches: matches,
+	}, Undefined
+}
+
+func (*RegexMatchIterator) Class() *Class {
+	return RegexMatchIteratorClass
+}
+
+func (*RegexMatchIterator) DirectClass() *Class {
+	return RegexMatchIteratorClass
+}
+
+func (*RegexMatchIterator) SingletonClass() *Class {
+	return nil
+}
+
+func (r *RegexMatchIterator) Copy() Reference {
+	return &RegexMatchIterator{
+		Regex:   r.Regex,
+		Subject: r.Subject,
+		Matches: r.Matches,
+		Index:   r.Index,
+	}
+}
+
+func (r *RegexMatchIterator) ToValue() Value {
+	return Ref(r)
+}
+
+func (r *RegexMatchIterator) Inspect() string {
+	return fmt.Sprintf(
+		"Std::Regex::MatchIterator{regex: %s, string: %s, index: %d}",
+		r.Regex.Inspect(),
+		String(r.Subject).Inspect(),
+		r.Index,
+	)
+}
+
+func (r *RegexMatchIterator) Error() string {
+	return r.Inspect()
+}
+
+func (*RegexMatchIterator) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+func (r *RegexMatchIterator) NextValue() (Value, Value) {
+	if r.Index >= len(r.Matches) {
+		return Undefined, stopIterationSymbol.ToValue()
+	}
+
+	match := NewRegexMatch(r.Regex, r.Subject, r.Matches[r.Index])
+	r.Index++
+	return Ref(match), Undefined
+}
+
+func (r *RegexMatchIterator) Reset() {
+	r.Index = 0
+}
+
+// Returns the first match of the regex in the given string
+// or nil when there is no match.
+func (r *Regex) Match(subject string) (*RegexMatch, Value) {
+	indices, err := r.FindSubmatchIndex(subject)
+	if indices == nil {
+		return nil, err
+	}
+	return NewRegexMatch(r, subject, indices), Undefined
+}
+
+// Replace the first (when `all` is false) or all matches of the regex
+// using the strings returned by `replacement`.
+func (r *Regex) ReplaceFunc(subject string, all bool, replacement func(*RegexMatch) (string, Value)) (String, Value) {
+	n := 1
+	if all {
+		n = -1
+	}
+	matches, err := r.FindAllSubmatchIndex(subject, n)
+	if !err.IsUndefined() {
+		return "", err
+	}
+	if len(matches) == 0 {
+		return String(subject), Undefined
+	}
+
+	var buff strings.Builder
+	var lastEnd int
+	for _, indices := range matches {
+		buff.WriteString(subject[lastEnd:indices[0]])
+		str, err := replacement(NewRegexMatch(r, subject, indices))
+		if !err.IsUndefined() {
+			return "", err
+		}
+		buff.WriteString(str)
+		lastEnd = indices[1]
+	}
+	buff.WriteString(subject[lastEnd:])
+	return String(buff.String()), Undefined
+}
@@ -0,0 +1,249 @@
+package value_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elk-language/elk/bitfield"
+	"github.com/elk-language/elk/comparer"
+	"github.com/elk-language/elk/value"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegexMatch_GroupVal(t *testing.T) {
+	re := value.MustCompileRegex(`(?<year>\d{4})-(\d{2})(x)?`, bitfield.BitField8{})
+	match, _ := re.Match("date: 2024-05")
+
+	tests := map[string]struct {
+		group value.Value
+		want  value.Value
+		err   value.Value
+	}{
+		"entire match": {
+			group: value.SmallInt(0).ToValue(),
+			want:  value.Ref(value.String("2024-05")),
+		},
+		"positional group": {
+			group: value.SmallInt(2).ToValue(),
+			want:  value.Ref(value.String("05")),
+		},
+		"negative index": {
+			group: value.SmallInt(-2).ToValue(),
+			want:  value.Ref(value.String("05")),
+		},
+		"named group by string": {
+			group: value.Ref(value.String("year")),
+			want:  value.Ref(value.String("2024")),
+		},
+		"named group by symbol": {
+			group: value.ToSymbol("year").ToValue(),
+			want:  value.Ref(value.String("2024")),
+		},
+		"group that did not participate": {
+			group: value.SmallInt(3).ToValue(),
+			want:  value.Nil,
+		},
+		"index out of range": {
+			group: value.SmallInt(4).ToValue(),
+			want:  value.Undefined,
+			err:   value.Ref(value.NewIndexOutOfRangeError("4", 4)),
+		},
+		"undefined name": {
+			group: value.Ref(value.String("foo")),
+			want:  value.Undefined,
+			err:   value.Ref(value.NewError(value.IndexErrorClass, `undefined capture group: "foo"`)),
+		},
+		"invalid group": {
+			group: value.Float(1.5).ToValue(),
+			want:  value.Undefined,
+			err:   value.Ref(value.NewError(value.TypeErrorClass, "invalid capture group: 1.5")),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := match.GroupVal(tc.group)
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(tc.err, err, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestRegexMatch_CharBounds(t *testing.T) {
+	re := value.MustCompileRegex(`ą(\d+)`, bitfield.BitField8{})
+	match, _ := re.Match("śląz ą123")
+
+	start, end, ok, err := match.CharBounds(value.Undefined)
+	if !ok || !err.IsUndefined() || start != 5 || end != 9 {
+		t.Fatalf("unexpected bounds of the match: %d, %d", start, end)
+	}
+
+	start, end, ok, err = match.CharBounds(value.SmallInt(1).ToValue())
+	if !ok || !err.IsUndefined() || start != 6 || end != 9 {
+		t.Fatalf("unexpected bounds of the group: %d, %d", start, end)
+	}
+}
+
+func TestRegex_ReplaceFunc(t *testing.T) {
+	tests := map[string]struct {
+		regex   string
+		subject string
+		all     bool
+		want    value.String
+	}{
+		"replace the first match": {
+			regex:   `(\w)(\d)`,
+			subject: "a1 b2 c3",
+			want:    "1a b2 c3",
+		},
+		"replace all matches": {
+			regex:   `(\w)(\d)`,
+			subject: "a1 b2 c3",
+			all:     true,
+			want:    "1a 2b 3c",
+		},
+		"backtracking regex": {
+			regex:   `(\w)(?=\d)(\d)`,
+			subject: "a1 b2 c3",
+			all:     true,
+			want:    "1a 2b 3c",
+		},
+		"no matches": {
+			regex:   `x`,
+			subject: "a1 b2 c3",
+			all:     true,
+			want:    "a1 b2 c3",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			re := value.MustCompileRegex(tc.regex, bitfield.BitField8{})
+			got, err := re.ReplaceFunc(tc.subject, tc.all, func(m *value.RegexMatch) (string, value.Value) {
+				return m.Expand("$2$1"), value.Undefined
+			})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatal(diff)
+			}
+			if !err.IsUndefined() {
+				t.Fatalf("unexpected error: %s", err.Inspect())
+			}
+		})
+	}
+}
+
+func TestCompileRegex_Backtracking(t *testing.T) {
+	tests := map[string]struct {
+		regex        string
+		backtracking bool
+	}{
+		"simple regex":     {regex: `\w+\d`},
+		"lookahead":        {regex: `\w+(?=\d)`, backtracking: true},
+		"lookbehind":       {regex: `(?<=\d)\w+`, backtracking: true},
+		"backreference":    {regex: `(\w)\1`, backtracking: true},
+		"octal escape":     {regex: `\1`},
+		"atomic group":     {regex: `(?>a+)`, backtracking: true},
+		"possessive":       {regex: `a*+`, backtracking: true},
+		"nested lookahead": {regex: `(a|b(?=c))+`, backtracking: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			re := value.MustCompileRegex(tc.regex, bitfield.BitField8{})
+			if re.IsBacktracking() != tc.backtracking {
+				t.Fatalf("expected backtracking: %t, got: %t", tc.backtracking, re.IsBacktracking())
+			}
+		})
+	}
+}
+
+func TestCompileRegex_TranspileError(t *testing.T) {
+	_, err := value.CompileRegex(`[^\W\d]`, bitfield.BitField8{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	want := `double negation of unicode-aware \W is not supported`
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("expected error containing %q, got: %s", want, err)
+	}
+}
+
+func TestLaxEqual_Regex(t *testing.T) {
+	tests := map[string]struct {
+		left  value.Value
+		right value.Value
+		want  bool
+	}{
+		"regex matching string":              {left: value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})), right: value.Ref(value.String("a1")), want: true},
+		"regex not matching string":          {left: value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})), right: value.Ref(value.String("ab"))},
+		"string matching regex":              {left: value.Ref(value.String("a1")), right: value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})), want: true},
+		"equal regexes":                      {left: value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})), right: value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})), want: true},
+		"backtracking regex matching string": {left: value.Ref(value.MustCompileRegex(`\d(?=b)`, bitfield.BitField8{})), right: value.Ref(value.String("a1b")), want: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := value.LaxEqual(tc.left, tc.right)
+			if got != tc.want {
+				t.Fatalf("LaxEqual: expected %t, got %t", tc.want, got)
+			}
+			if val := value.LaxEqualVal(tc.left, tc.right); !val.IsUndefined() && val.IsTrue() != tc.want {
+				t.Fatalf("LaxEqualVal: expected %t, got %s", tc.want, val.Inspect())
+			}
+		})
+	}
+}
+
+func TestRegex_Split(t *testing.T) {
+	tests := map[string]struct {
+		regex   string
+		subject string
+		limit   int
+		want    []string
+	}{
+		"split by lookbehind": {
+			regex:   `(?<=,)`,
+			subject: "a,b,c",
+			limit:   -1,
+			want:    []string{"a,", "b,", "c"},
+		},
+		"limit": {
+			regex:   `(?<=\d)\s*,`,
+			subject: "1, 2, 3",
+			limit:   2,
+			want:    []string{"1", " 2, 3"},
+		},
+		"empty subject": {
+			regex:   `(?=a)`,
+			subject: "",
+			limit:   -1,
+			want:    []string{""},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			re := value.MustCompileRegex(tc.regex, bitfield.BitField8{})
+			got, err := re.Split(tc.subject, tc.limit)
+			if !err.IsUndefined() {
+				t.Fatalf("unexpected error: %s", err.Inspect())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestRegex_StepLimit(t *testing.T) {
+	re := value.MustCompileRegex(`(?=x)|(a+)+b`, bitfield.BitField8{})
+	_, err := re.Match("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	if err.IsUndefined() || !value.IsA(err, value.RegexStepLimitErrorClass) {
+		t.Fatalf("expected a step limit error, got: %s", err.Inspect())
+	}
+}
@@ -0,0 +1,127 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+var SemaphoreClass *Class             // ::Std::Sync::Semaphore
+var SemaphoreReleaseErrorClass *Class // ::Std::Sync::Semaphore::ReleaseError
+
+// A counting semaphore.
+// Every acquired permit occupies a slot in the buffer of the channel.
+type Semaphore struct {
+	permits chan struct{}
+}
+
+func NewSemaphore(permits int) *Semaphore {
+	return &Semaphore{
+		permits: make(chan struct{}, permits),
+	}
+}
+
+func (s *Semaphore) Copy() Reference {
+	return s
+}
+
+func (s *Semaphore) ToValue() Value {
+	return Ref(s)
+}
+
+func (*Semaphore) Class() *Class {
+	return SemaphoreClass
+}
+
+func (*Semaphore) DirectClass() *Class {
+	return SemaphoreClass
+}
+
+func (*Semaphore) SingletonClass() *Class {
+	return nil
+}
+
+func (s *Semaphore) Inspect() string {
+	return fmt.Sprintf(
+		"Std::Sync::Semaphore{&: %p, permits: %d, available_permits: %d}",
+		s,
+		s.Permits(),
+		s.AvailablePermits(),
+	)
+}
+
+func (s *Semaphore) Error() string {
+	return s.Inspect()
+}
+
+func (*Semaphore) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the total number of permits.
+func (s *Semaphore) Permits() int {
+	return cap(s.permits)
+}
+
+// Returns the number of permits that can be acquired without blocking.
+func (s *Semaphore) AvailablePermits() int {
+	return cap(s.permits) - len(s.permits)
+}
+
+// Acquire a permit, blocks until one is available
+// or the context is done.
+func (s *Semaphore) Acquire(ctx context.Context) (err Value) {
+	select {
+	case s.permits <- struct{}{}:
+		return Undefined
+	case <-ctx.Done():
+		return ExecutionAbortedError.ToValue()
+	}
+}
+
+// Try to acquire a permit without blocking.
+func (s *Semaphore) TryAcquire() bool {
+	select {
+	case s.permits <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Try to acquire a permit, blocks until one is available
+// or the timeout elapses.
+// Returns an error when the context is done.
+func (s *Semaphore) TryAcquireTimeout(ctx context.Context, timeout TimeSpan) (ok bool, err Value) {
+	timer := time.NewTimer(timeout.Native())
+	defer timer.Stop()
+
+	select {
+	case s.permits <- struct{}{}:
+		return true, Undefined
+	case <-timer.C:
+		return false, Undefined
+	case <-ctx.Done():
+		return false, ExecutionAbortedError.ToValue()
+	}
+}
+
+// Release a permit.
+func (s *Semaphore) Release() (err Value) {
+	select {
+	case <-s.permits:
+		return Undefined
+	default:
+		return Ref(NewError(SemaphoreReleaseErrorClass, "cannot release a semaphore without acquired permits"))
+	}
+}
+
+func initSemaphore() {
+	SemaphoreClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("Semaphore", Ref(SemaphoreClass))
+	RegisterNativeClass("Std::Sync::Semaphore", "value.SemaphoreClass")
+
+	SemaphoreReleaseErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	SemaphoreClass.AddConstantString("ReleaseError", Ref(SemaphoreReleaseErrorClass))
+	RegisterNativeClass("Std::Sync::Semaphore::ReleaseError", "value.SemaphoreReleaseErrorClass")
+}
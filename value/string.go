@@ -335,6 +335,427 @@ func (s String) RemoveSuffix(other Value) (String, Value) {
 	}
 }
 
+// Return a copy of the string without the given prefix.
+func (s String) RemovePrefix(other Value) (String, Value) {
+	if other.IsReference() {
+		switch o := other.AsReference().(type) {
+		case String:
+			result, _ := strings.CutPrefix(string(s), string(o))
+			return String(result), Undefined
+		default:
+			return "", Ref(NewCoerceError(s.Class(), other.Class()))
+		}
+	}
+
+	switch other.ValueFlag() {
+	case CHAR_FLAG:
+		o := other.AsChar()
+		r, rLen := utf8.DecodeRuneInString(string(s))
+		if len(s) > 0 && r == rune(o) {
+			return s[rLen:], Undefined
+		}
+		return s, Undefined
+	default:
+		return "", Ref(NewCoerceError(s.Class(), other.Class()))
+	}
+}
+
+// Check whether the string starts with the given `String` or `Char`.
+func (s String) StartsWith(other Value) (bool, Value) {
+	prefix, err := stringPattern(other)
+	if !err.IsUndefined() {
+		return false, err
+	}
+	return strings.HasPrefix(string(s), prefix), Undefined
+}
+
+// Check whether the string ends with the given `String` or `Char`.
+func (s String) EndsWith(other Value) (bool, Value) {
+	suffix, err := stringPattern(other)
+	if !err.IsUndefined() {
+		return false, err
+	}
+	return strings.HasSuffix(string(s), suffix), Undefined
+}
+
+// Returns the Go string represented by a `String` or `Char` pattern.
+func stringPattern(pattern Value) (string, Value) {
+	if pattern.IsReference() {
+		switch p := pattern.AsReference().(type) {
+		case String:
+			return string(p), Undefined
+		default:
+			return "", Ref(Errorf(TypeErrorClass, "cannot use %s as a string pattern", pattern.Inspect()))
+		}
+	}
+
+	switch pattern.ValueFlag() {
+	case CHAR_FLAG:
+		return string(pattern.AsChar().Rune()), Undefined
+	default:
+		return "", Ref(Errorf(TypeErrorClass, "cannot use %s as a string pattern", pattern.Inspect()))
+	}
+}
+
+// Find the first match of a `String`, `Char` or `Regex` pattern
+// starting at the given byte offset.
+// Returns the byte bounds of the match or -1 as `start`
+// when the pattern cannot be found.
+func (s String) find(pattern Value, byteOffset int) (start, end int, err Value) {
+	if byteOffset < 0 || byteOffset > len(s) {
+		return -1, -1, Undefined
+	}
+	str := string(s[byteOffset:])
+
+	if re, ok := pattern.SafeAsReference().(*Regex); ok {
+		loc, err := re.FindSubmatchIndex(str)
+		if loc == nil {
+			return -1, -1, err
+		}
+		return byteOffset + loc[0], byteOffset + loc[1], Undefined
+	}
+
+	substr, err := stringPattern(pattern)
+	if !err.IsUndefined() {
+		return -1, -1, err
+	}
+	i := strings.Index(str, substr)
+	if i == -1 {
+		return -1, -1, Undefined
+	}
+	return byteOffset + i, byteOffset + i + len(substr), Undefined
+}
+
+// Find the last match of a `String`, `Char` or `Regex` pattern.
+// Returns the byte index of the match or -1
+// when the pattern cannot be found.
+func (s String) findLast(pattern Value) (int, Value) {
+	if re, ok := pattern.SafeAsReference().(*Regex); ok {
+		matches, err := re.FindAllSubmatchIndex(string(s), -1)
+		if len(matches) == 0 {
+			return -1, err
+		}
+		return matches[len(matches)-1][0], Undefined
+	}
+
+	substr, err := stringPattern(pattern)
+	if !err.IsUndefined() {
+		return -1, err
+	}
+	return strings.LastIndex(string(s), substr), Undefined
+}
+
+// Check whether the string contains the given `String`, `Char` or `Regex`.
+func (s String) Contains(pattern Value) (bool, Value) {
+	start, _, err := s.find(pattern, 0)
+	if !err.IsUndefined() {
+		return false, err
+	}
+	return start != -1, Undefined
+}
+
+// Returns the byte index of the first match of the pattern
+// starting at the byte with the given index.
+// Returns -1 when the pattern cannot be found.
+func (s String) ByteIndexOf(pattern Value, start int) (int, Value) {
+	if start < 0 {
+		start += len(s)
+		if start < 0 {
+			start = 0
+		}
+	}
+	i, _, err := s.find(pattern, start)
+	return i, err
+}
+
+// Returns the char index of the first match of the pattern
+// starting at the char with the given index.
+// Returns -1 when the pattern cannot be found.
+func (s String) IndexOf(pattern Value, start int) (int, Value) {
+	i, _, err := s.find(pattern, s.charByteOffset(start))
+	if !err.IsUndefined() || i == -1 {
+		return -1, err
+	}
+	return utf8.RuneCountInString(string(s[:i])), Undefined
+}
+
+// Returns the index of the grapheme that contains the first match of the pattern
+// starting at the grapheme with the given index.
+// Returns -1 when the pattern cannot be found.
+func (s String) GraphemeIndexOf(pattern Value, start int) (int, Value) {
+	i, _, err := s.find(pattern, s.graphemeByteOffset(start))
+	if !err.IsUndefined() || i == -1 {
+		return -1, err
+	}
+	return s.graphemeIndex(i), Undefined
+}
+
+// Returns the char index of the last match of the pattern.
+// Returns -1 when the pattern cannot be found.
+func (s String) LastIndexOf(pattern Value) (int, Value) {
+	i, err := s.findLast(pattern)
+	if !err.IsUndefined() || i == -1 {
+		return -1, err
+	}
+	return utf8.RuneCountInString(string(s[:i])), Undefined
+}
+
+// Returns the byte offset of the char with the given index.
+// Negative indices are counted from the end of the string.
+// Returns the length of the string when the index is too large.
+func (s String) charByteOffset(index int) int {
+	if index < 0 {
+		index += s.CharCount()
+		if index < 0 {
+			return 0
+		}
+	}
+
+	var i int
+	for offset := range string(s) {
+		if i == index {
+			return offset
+		}
+		i++
+	}
+	return len(s)
+}
+
+// Returns the byte offset of the grapheme with the given index.
+// Negative indices are counted from the end of the string.
+// Returns the length of the string when the index is too large.
+func (s String) graphemeByteOffset(index int) int {
+	if index < 0 {
+		index += s.GraphemeCount()
+		if index < 0 {
+			return 0
+		}
+	}
+
+	str := string(s)
+	state := -1
+	var cluster string
+	var offset int
+	for i := 0; len(str) > 0; i++ {
+		if i == index {
+			return offset
+		}
+		cluster, str, _, state = uniseg.FirstGraphemeClusterInString(str, state)
+		offset += len(cluster)
+	}
+	return len(s)
+}
+
+// Returns the index of the grapheme that contains
+// the byte with the given offset.
+func (s String) graphemeIndex(byteOffset int) int {
+	str := string(s)
+	state := -1
+	var cluster string
+	var offset, i int
+	for len(str) > 0 {
+		cluster, str, _, state = uniseg.FirstGraphemeClusterInString(str, state)
+		offset += len(cluster)
+		if offset > byteOffset {
+			return i
+		}
+		i++
+	}
+	return i
+}
+
+// Split the string into substrings separated by a `String`, `Char` or `Regex`.
+// `limit` is the maximum number of substrings,
+// all substrings are returned when it is negative.
+func (s String) Split(separator Value, limit int) (*ArrayListOfValue, Value) {
+	var parts []string
+	if re, ok := separator.SafeAsReference().(*Regex); ok {
+		var err Value
+		parts, err = re.Split(string(s), limit)
+		if !err.IsUndefined() {
+			return nil, err
+		}
+	} else {
+		sep, err := stringPattern(separator)
+		if !err.IsUndefined() {
+			return nil, err
+		}
+		parts = strings.SplitN(string(s), sep, limit)
+	}
+
+	result := NewArrayListOfValue(len(parts))
+	for _, part := range parts {
+		result.Append(Ref(String(part)))
+	}
+	return result, Undefined
+}
+
+// Split the string into lines.
+// Line terminators (`\n` and `\r\n`) are not included in the result.
+func (s String) Lines() *ArrayListOfValue {
+	str := strings.TrimSuffix(string(s), "\n")
+	if len(s) == 0 {
+		return NewArrayListOfValue(0)
+	}
+
+	lines := strings.Split(str, "\n")
+	result := NewArrayListOfValue(len(lines))
+	for _, line := range lines {
+		result.Append(Ref(String(strings.TrimSuffix(line, "\r"))))
+	}
+	return result
+}
+
+// Replace the first match of a `String`, `Char` or `Regex` pattern
+// with the replacement.
+// In case of a `Regex` the replacement may refer to
+// capture groups like `$1` or `${name}`.
+func (s String) Replace(pattern Value, replacement String) (String, Value) {
+	if re, ok := pattern.SafeAsReference().(*Regex); ok {
+		match, err := re.FindSubmatchIndex(string(s))
+		if match == nil {
+			return s, err
+		}
+		var buff strings.Builder
+		buff.WriteString(string(s[:match[0]]))
+		buff.Write(re.ExpandString(nil, string(replacement), string(s), match))
+		buff.WriteString(string(s[match[1]:]))
+		return String(buff.String()), Undefined
+	}
+
+	old, err := stringPattern(pattern)
+	if !err.IsUndefined() {
+		return "", err
+	}
+	return String(strings.Replace(string(s), old, string(replacement), 1)), Undefined
+}
+
+// Replace all matches of a `String`, `Char` or `Regex` pattern
+// with the replacement.
+// In case of a `Regex` the replacement may refer to
+// capture groups like `$1` or `${name}`.
+func (s String) ReplaceAll(pattern Value, replacement String) (String, Value) {
+	if re, ok := pattern.SafeAsReference().(*Regex); ok {
+		if re.IsBacktracking() {
+			return re.ReplaceFunc(string(s), true, func(m *RegexMatch) (string, Value) {
+				return m.Expand(string(replacement)), Undefined
+			})
+		}
+		return String(re.Re.ReplaceAllString(string(s), string(replacement))), Undefined
+	}
+
+	old, err := stringPattern(pattern)
+	if !err.IsUndefined() {
+		return "", err
+	}
+	return String(strings.ReplaceAll(string(s), old, string(replacement))), Undefined
+}
+
+// Remove leading and trailing whitespace.
+func (s String) Trim() String {
+	return String(strings.TrimSpace(string(s)))
+}
+
+// Remove leading whitespace.
+func (s String) TrimStart() String {
+	return String(strings.TrimLeftFunc(string(s), unicode.IsSpace))
+}
+
+// Remove trailing whitespace.
+func (s String) TrimEnd() String {
+	return String(strings.TrimRightFunc(string(s), unicode.IsSpace))
+}
+
+// Center the string in a string of the given length (in chars)
+// using the given char for padding.
+// When the padding can't be split evenly
+// the right side gets one more char.
+func (s String) Center(targetLen int, padding Char) String {
+	charCount := s.CharCount()
+	if charCount >= targetLen {
+		return s
+	}
+
+	total := targetLen - charCount
+	left := total / 2
+	right := total - left
+
+	var buff strings.Builder
+	for range left {
+		buff.WriteRune(padding.Rune())
+	}
+	buff.WriteString(string(s))
+	for range right {
+		buff.WriteRune(padding.Rune())
+	}
+
+	return String(buff.String())
+}
+
+// Convert the first char to uppercase and the rest to lowercase.
+func (s String) Capitalize() String {
+	r, size := utf8.DecodeRuneInString(string(s))
+	if size == 0 {
+		return s
+	}
+
+	var buff strings.Builder
+	buff.WriteRune(unicode.ToUpper(r))
+	buff.WriteString(strings.ToLower(string(s[size:])))
+	return String(buff.String())
+}
+
+// Returns a list of all chars of the string.
+func (s String) Chars() *ArrayListOfValue {
+	result := NewArrayListOfValue(len(s))
+	for _, r := range string(s) {
+		result.Append(Char(r).ToValue())
+	}
+	return result
+}
+
+// Returns a list of all grapheme clusters of the string.
+func (s String) Graphemes() *ArrayListOfValue {
+	result := NewArrayListOfValue(len(s))
+	str := string(s)
+	state := -1
+	var cluster string
+	for len(str) > 0 {
+		cluster, str, _, state = uniseg.FirstGraphemeClusterInString(str, state)
+		result.Append(Ref(String(cluster)))
+	}
+	return result
+}
+
+// Returns a list of all bytes of the string.
+func (s String) Bytes() *ArrayListOfValue {
+	result := NewArrayListOfValue(len(s))
+	for i := range len(s) {
+		result.Append(UInt8(s[i]).ToValue())
+	}
+	return result
+}
+
+// Returns the chars in the range `start..<end`.
+func (s String) SliceChars(start, end int) String {
+	if start >= end {
+		return ""
+	}
+	startOffset := s.charByteOffset(start)
+	endOffset := s.charByteOffset(end)
+	return s[startOffset:endOffset]
+}
+
+// Returns the grapheme clusters in the range `start..<end`.
+func (s String) SliceGraphemes(start, end int) String {
+	if start >= end {
+		return ""
+	}
+	startOffset := s.graphemeByteOffset(start)
+	endOffset := s.graphemeByteOffset(end)
+	return s[startOffset:endOffset]
+}
+
 // Returns 1 if i is greater than other
 // Returns 0 if both are equal.
 // Returns -1 if i is less than other.
@@ -505,17 +926,40 @@ func (s String) LessThanEqualChar(other Char) bool {
 	return s <= String(other)
 }
 
-// Check whether s is equal to other
+// Check whether s is equal to other.
+// Returns undefined when other is a `Regex`
+// executed by the backtracking engine
+// since the search may fail, use `LaxEqualErr` instead.
 func (s String) LaxEqualVal(other Value) Value {
+	if o, ok := other.SafeAsReference().(*Regex); ok && o.IsBacktracking() {
+		return Undefined
+	}
 	return BoolVal(s.LaxEqual(other))
 }
 
-// Check whether s is equal to other
+// Check whether s is equal to other.
+// When other is a `Regex` checks whether it matches s.
+// Returns an error when the search exceeds the step limit.
+func (s String) LaxEqualErr(other Value) (bool, Value) {
+	if o, ok := other.SafeAsReference().(*Regex); ok {
+		return o.MatchString(string(s))
+	}
+	return s.LaxEqual(other), Undefined
+}
+
+// Check whether s is equal to other.
+// When other is a `Regex` checks whether it matches s,
+// a search that exceeds the step limit is not reported
+// so `LaxEqualErr` has to be used for regexes
+// executed by the backtracking engine.
 func (s String) LaxEqual(other Value) bool {
 	if other.IsReference() {
 		switch o := other.AsReference().(type) {
 		case String:
 			return s == o
+		case *Regex:
+			matched, _ := o.MatchString(string(s))
+			return matched
 		default:
 			return false
 		}
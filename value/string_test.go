@@ -3,6 +3,7 @@ package value_test
 import (
 	"testing"
 
+	"github.com/elk-language/elk/bitfield"
 	"github.com/elk-language/elk/comparer"
 	"github.com/elk-language/elk/value"
 	"github.com/google/go-cmp/cmp"
@@ -542,6 +543,395 @@ func TestString_RemoveSuffix(t *testing.T) {
 	}
 }
 
+func TestString_RemovePrefix(t *testing.T) {
+	tests := map[string]struct {
+		str    value.String
+		prefix value.Value
+		want   value.String
+		err    value.Value
+	}{
+		"return a type error when int is given": {
+			str:    value.String("foo bar"),
+			prefix: value.SmallInt(3).ToValue(),
+			err:    value.Ref(value.NewError(value.TypeErrorClass, "`Std::Int` cannot be coerced into `Std::String`")),
+		},
+		"return a string without the given string prefix": {
+			str:    value.String("foo bar"),
+			prefix: value.Ref(value.String("foo")),
+			want:   value.String(" bar"),
+		},
+		"return the same string if there is no such string prefix": {
+			str:    value.String("foo bar"),
+			prefix: value.Ref(value.String("bar")),
+			want:   value.String("foo bar"),
+		},
+		"return a string without the given char prefix": {
+			str:    value.String("ślązak"),
+			prefix: value.Char('ś').ToValue(),
+			want:   value.String("lązak"),
+		},
+		"return the same string if there is no such char prefix": {
+			str:    value.String("foo bar"),
+			prefix: value.Char('r').ToValue(),
+			want:   value.String("foo bar"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.str.RemovePrefix(tc.prefix)
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(tc.err, err, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestString_IndexOf(t *testing.T) {
+	tests := map[string]struct {
+		str     value.String
+		pattern value.Value
+		start   int
+		want    int
+		err     value.Value
+	}{
+		"return a type error when int is given": {
+			str:     value.String("foo bar"),
+			pattern: value.SmallInt(3).ToValue(),
+			want:    -1,
+			err:     value.Ref(value.NewError(value.TypeErrorClass, "cannot use 3 as a string pattern")),
+		},
+		"find a string": {
+			str:     value.String("ślązak ślązak"),
+			pattern: value.Ref(value.String("zak")),
+			want:    3,
+		},
+		"find a string after start": {
+			str:     value.String("ślązak ślązak"),
+			pattern: value.Ref(value.String("zak")),
+			start:   4,
+			want:    10,
+		},
+		"find a string after negative start": {
+			str:     value.String("ślązak ślązak"),
+			pattern: value.Ref(value.String("zak")),
+			start:   -3,
+			want:    10,
+		},
+		"find a char": {
+			str:     value.String("ślązak"),
+			pattern: value.Char('z').ToValue(),
+			want:    3,
+		},
+		"find a regex": {
+			str:     value.String("ślązak 123"),
+			pattern: value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})),
+			want:    7,
+		},
+		"return -1 when not found": {
+			str:     value.String("ślązak"),
+			pattern: value.Ref(value.String("foo")),
+			want:    -1,
+		},
+		"return -1 when start is out of range": {
+			str:     value.String("ślązak"),
+			pattern: value.Ref(value.String("zak")),
+			start:   10,
+			want:    -1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.str.IndexOf(tc.pattern, tc.start)
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(tc.err, err, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestString_GraphemeIndexOf(t *testing.T) {
+	tests := map[string]struct {
+		str     value.String
+		pattern value.Value
+		start   int
+		want    int
+	}{
+		"find a string": {
+			str:     value.String("🇵🇱 e\u0301 foo"),
+			pattern: value.Ref(value.String("foo")),
+			want:    4,
+		},
+		"return the grapheme containing the match": {
+			str:     value.String("🇵🇱 e\u0301 foo"),
+			pattern: value.Char('\u0301').ToValue(),
+			want:    2,
+		},
+		"find a string after start": {
+			str:     value.String("a🇵🇱a🇵🇱"),
+			pattern: value.Ref(value.String("🇵🇱")),
+			start:   2,
+			want:    3,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.str.GraphemeIndexOf(tc.pattern, tc.start)
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(value.Undefined, err, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestString_Split(t *testing.T) {
+	tests := map[string]struct {
+		str       value.String
+		separator value.Value
+		limit     int
+		want      *value.ArrayListOfValue
+	}{
+		"split by a string": {
+			str:       value.String("foo, bar, baz"),
+			separator: value.Ref(value.String(", ")),
+			limit:     -1,
+			want: &value.ArrayListOfValue{
+				value.Ref(value.String("foo")),
+				value.Ref(value.String("bar")),
+				value.Ref(value.String("baz")),
+			},
+		},
+		"split by a char with a limit": {
+			str:       value.String("foo,bar,baz"),
+			separator: value.Char(',').ToValue(),
+			limit:     2,
+			want: &value.ArrayListOfValue{
+				value.Ref(value.String("foo")),
+				value.Ref(value.String("bar,baz")),
+			},
+		},
+		"split by a regex": {
+			str:       value.String("foo1bar22baz"),
+			separator: value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})),
+			limit:     -1,
+			want: &value.ArrayListOfValue{
+				value.Ref(value.String("foo")),
+				value.Ref(value.String("bar")),
+				value.Ref(value.String("baz")),
+			},
+		},
+		"split into chars with an empty string": {
+			str:       value.String("łak"),
+			separator: value.Ref(value.String("")),
+			limit:     -1,
+			want: &value.ArrayListOfValue{
+				value.Ref(value.String("ł")),
+				value.Ref(value.String("a")),
+				value.Ref(value.String("k")),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.str.Split(tc.separator, tc.limit)
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(value.Undefined, err, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestString_Lines(t *testing.T) {
+	tests := map[string]struct {
+		str  value.String
+		want *value.ArrayListOfValue
+	}{
+		"empty string": {
+			str:  value.String(""),
+			want: &value.ArrayListOfValue{},
+		},
+		"without a trailing newline": {
+			str: value.String("foo\nbar"),
+			want: &value.ArrayListOfValue{
+				value.Ref(value.String("foo")),
+				value.Ref(value.String("bar")),
+			},
+		},
+		"with a trailing newline and CRLF": {
+			str: value.String("foo\r\n\nbar\n"),
+			want: &value.ArrayListOfValue{
+				value.Ref(value.String("foo")),
+				value.Ref(value.String("")),
+				value.Ref(value.String("bar")),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.str.Lines()
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestString_Replace(t *testing.T) {
+	tests := map[string]struct {
+		str         value.String
+		pattern     value.Value
+		replacement value.String
+		want        value.String
+		wantAll     value.String
+	}{
+		"replace a string": {
+			str:         value.String("foo bar foo"),
+			pattern:     value.Ref(value.String("foo")),
+			replacement: "baz",
+			want:        "baz bar foo",
+			wantAll:     "baz bar baz",
+		},
+		"replace a char": {
+			str:         value.String("ślązak"),
+			pattern:     value.Char('ą').ToValue(),
+			replacement: "a",
+			want:        "ślazak",
+			wantAll:     "ślazak",
+		},
+		"replace a regex with a template": {
+			str:         value.String("foo1 bar22"),
+			pattern:     value.Ref(value.MustCompileRegex(`([a-z]+)(\d+)`, bitfield.BitField8{})),
+			replacement: "$2$1",
+			want:        "1foo bar22",
+			wantAll:     "1foo 22bar",
+		},
+		"return the same string when not found": {
+			str:         value.String("foo"),
+			pattern:     value.Ref(value.String("bar")),
+			replacement: "baz",
+			want:        "foo",
+			wantAll:     "foo",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := comparer.Options()
+			got, err := tc.str.Replace(tc.pattern, tc.replacement)
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(value.Undefined, err, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+
+			got, err = tc.str.ReplaceAll(tc.pattern, tc.replacement)
+			if diff := cmp.Diff(tc.wantAll, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(value.Undefined, err, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestString_Center(t *testing.T) {
+	tests := map[string]struct {
+		str       value.String
+		targetLen int
+		padding   value.Char
+		want      value.String
+	}{
+		"even padding": {
+			str:       value.String("ął"),
+			targetLen: 6,
+			padding:   '*',
+			want:      "**ął**",
+		},
+		"uneven padding": {
+			str:       value.String("foo"),
+			targetLen: 6,
+			padding:   '-',
+			want:      "-foo--",
+		},
+		"longer string": {
+			str:       value.String("foo"),
+			targetLen: 2,
+			padding:   '-',
+			want:      "foo",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.str.Center(tc.targetLen, tc.padding)
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestString_SliceChars(t *testing.T) {
+	tests := map[string]struct {
+		str        value.String
+		start, end int
+		want       value.String
+	}{
+		"slice the middle": {
+			str:   value.String("ślązak"),
+			start: 1,
+			end:   4,
+			want:  "ląz",
+		},
+		"end out of range": {
+			str:   value.String("ślązak"),
+			start: 3,
+			end:   10,
+			want:  "zak",
+		},
+		"empty range": {
+			str:   value.String("ślązak"),
+			start: 3,
+			end:   2,
+			want:  "",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.str.SliceChars(tc.start, tc.end)
+			opts := comparer.Options()
+			if diff := cmp.Diff(tc.want, got, opts...); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
 func TestStringByteCount(t *testing.T) {
 	tests := map[string]struct {
 		str  value.String
@@ -1690,6 +2080,16 @@ func TestString_LaxEqual(t *testing.T) {
 		b    value.Value
 		want value.Value
 	}{
+		"'foo12' =~ %/\\d+/": {
+			a:    value.String("foo12"),
+			b:    value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})),
+			want: value.True.ToValue(),
+		},
+		"'foo' =~ %/\\d+/": {
+			a:    value.String("foo"),
+			b:    value.Ref(value.MustCompileRegex(`\d+`, bitfield.BitField8{})),
+			want: value.False.ToValue(),
+		},
 		"SmallInt '2' =~ 2": {
 			a:    value.String("2"),
 			b:    value.SmallInt(2).ToValue(),
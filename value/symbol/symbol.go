@@ -55,6 +55,7 @@ var (
 	Convertible          = value.ToSymbol("Convertible")
 	Inspectable          = value.ToSymbol("Inspectable")
 	AnyInt               = value.ToSymbol("AnyInt")
+	AnyFloat             = value.ToSymbol("AnyFloat")
 	Kernel               = value.ToSymbol("Kernel")
 	Range                = value.ToSymbol("Range")
 	BeginlessClosedRange = value.ToSymbol("BeginlessClosedRange")
@@ -135,6 +136,13 @@ var (
 	L_slice                     = value.ToSymbol("slice")
 	L_diagnostics               = value.ToSymbol("diagnostics")
 	L_source_map                = value.ToSymbol("source_map")
+	L_errors                    = value.ToSymbol("errors")
+	L_stack_traces              = value.ToSymbol("stack_traces")
+	L_one_for_one               = value.ToSymbol("one_for_one")
+	L_one_for_all               = value.ToSymbol("one_for_all")
+	L_read                      = value.ToSymbol("read")
+	L_write                     = value.ToSymbol("write")
+	L_format                    = value.ToSymbol("format")
 )
 
 // special symbols
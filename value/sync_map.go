@@ -0,0 +1,10 @@
+package value
+
+var SyncMapClass *Class // ::Std::Sync::Map
+
+func initSyncMap() {
+	SyncMapClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncMapClass.IncludeMixin(IterableFiniteBaseMixin)
+	SyncModule.AddConstantString("Map", Ref(SyncMapClass))
+	RegisterNativeClass("Std::Sync::Map", "value.SyncMapClass")
+}
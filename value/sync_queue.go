@@ -0,0 +1,178 @@
+package value
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync/atomic"
+)
+
+var SyncQueueClass *Class // ::Std::Sync::Queue
+
+type syncQueueNode struct {
+	val  Value
+	next atomic.Pointer[syncQueueNode]
+}
+
+// A lock-free, unbounded multi-producer multi-consumer queue
+// (Michael-Scott queue).
+type SyncQueue struct {
+	head   atomic.Pointer[syncQueueNode]
+	tail   atomic.Pointer[syncQueueNode]
+	length atomic.Int64
+	// wakes up a thread blocked in `Pop`
+	notify chan struct{}
+}
+
+func NewSyncQueue() *SyncQueue {
+	q := &SyncQueue{
+		notify: make(chan struct{}, 1),
+	}
+	dummy := &syncQueueNode{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+func (q *SyncQueue) Copy() Reference {
+	return q
+}
+
+func (q *SyncQueue) ToValue() Value {
+	return Ref(q)
+}
+
+func (*SyncQueue) Class() *Class {
+	return SyncQueueClass
+}
+
+func (*SyncQueue) DirectClass() *Class {
+	return SyncQueueClass
+}
+
+func (*SyncQueue) SingletonClass() *Class {
+	return nil
+}
+
+func (q *SyncQueue) Inspect() string {
+	return fmt.Sprintf("Std::Sync::Queue{&: %p, length: %d}", q, q.Length())
+}
+
+func (q *SyncQueue) Error() string {
+	return q.Inspect()
+}
+
+func (*SyncQueue) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the number of elements in the queue.
+// The result may already be stale when other threads
+// push or pop concurrently.
+func (q *SyncQueue) Length() int {
+	return int(max(q.length.Load(), 0))
+}
+
+func (q *SyncQueue) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+// Append a value to the end of the queue.
+func (q *SyncQueue) Push(val Value) {
+	node := &syncQueueNode{val: val}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// the tail is lagging behind, help move it forward
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			break
+		}
+	}
+	q.length.Add(1)
+	q.wakeUp()
+}
+
+// Remove and return the first value of the queue.
+// Returns false when the queue is empty.
+func (q *SyncQueue) TryPop() (Value, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			return Undefined, false
+		}
+		if head == tail {
+			// the tail is lagging behind, help move it forward
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		val := next.val
+		if q.head.CompareAndSwap(head, next) {
+			q.length.Add(-1)
+			return val, true
+		}
+	}
+}
+
+// Remove and return the first value of the queue.
+// Blocks until a value is available or the context is done.
+func (q *SyncQueue) Pop(ctx context.Context) (val Value, err Value) {
+	for {
+		if val, ok := q.TryPop(); ok {
+			if !q.IsEmpty() {
+				// pass the wake up call on to another waiting thread
+				q.wakeUp()
+			}
+			return val, Undefined
+		}
+
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+			return Undefined, ExecutionAbortedError.ToValue()
+		}
+	}
+}
+
+func (q *SyncQueue) wakeUp() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Returns the values present in the queue at the time of the call.
+func (q *SyncQueue) Snapshot() *ArrayListOfValue {
+	list := NewArrayListOfValue(q.Length())
+	for node := q.head.Load().next.Load(); node != nil; node = node.next.Load() {
+		list.Append(node.val)
+	}
+	return list
+}
+
+func (q *SyncQueue) Iterate() iter.Seq2[Value, Value] {
+	return q.Snapshot().Iterate()
+}
+
+func (q *SyncQueue) Iter() NativeIterator {
+	return q.Snapshot().Iter()
+}
+
+func initSyncQueue() {
+	SyncQueueClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncQueueClass.IncludeMixin(IterableFiniteBaseMixin)
+	SyncModule.AddConstantString("Queue", Ref(SyncQueueClass))
+	RegisterNativeClass("Std::Sync::Queue", "value.SyncQueueClass")
+}
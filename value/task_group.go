@@ -0,0 +1,21 @@
+package value
+
+var TaskGroupClass *Class      // ::Std::Sync::TaskGroup
+var TaskGroupErrorClass *Class // ::Std::Sync::TaskGroup::Error
+
+func initTaskGroup() {
+	TaskGroupClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	SyncModule.AddConstantString("TaskGroup", Ref(TaskGroupClass))
+	RegisterNativeClass("Std::Sync::TaskGroup", "value.TaskGroupClass")
+
+	TaskGroupErrorClass = NewClassWithOptions(
+		ClassWithSuperclass(ErrorClass),
+		ClassWithIvarIndices(IvarIndices{
+			ToSymbol("message"):      0,
+			ToSymbol("errors"):       1,
+			ToSymbol("stack_traces"): 2,
+		}),
+	)
+	TaskGroupClass.AddConstantString("Error", Ref(TaskGroupErrorClass))
+	RegisterNativeClass("Std::Sync::TaskGroup::Error", "value.TaskGroupErrorClass")
+}
@@ -1,9 +1,19 @@
 package value
 
-var ThreadClass *Class // ::Std::Thread
+var ThreadClass *Class             // ::Std::Thread
+var ThreadErrorClass *Class        // ::Std::Thread::Error
+var ThreadTimeoutErrorClass *Class // ::Std::Thread::TimeoutError
 
 func initThread() {
 	ThreadClass = NewClass()
 	StdModule.AddConstantString("Thread", Ref(ThreadClass))
 	RegisterNativeClass("Std::Thread", "value.ThreadClass")
+
+	ThreadErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	ThreadClass.AddConstantString("Error", Ref(ThreadErrorClass))
+	RegisterNativeClass("Std::Thread::Error", "value.ThreadErrorClass")
+
+	ThreadTimeoutErrorClass = NewClassWithOptions(ClassWithSuperclass(ThreadErrorClass))
+	ThreadClass.AddConstantString("TimeoutError", Ref(ThreadTimeoutErrorClass))
+	RegisterNativeClass("Std::Thread::TimeoutError", "value.ThreadTimeoutErrorClass")
 }
@@ -1,9 +1,19 @@
 package value
 
-var ThreadPoolClass *Class // ::Std::ThreadPool
+var ThreadPoolClass *Class            // ::Std::ThreadPool
+var ThreadPoolErrorClass *Class       // ::Std::ThreadPool::Error
+var ThreadPoolClosedErrorClass *Class // ::Std::ThreadPool::ClosedError
 
 func initThreadPool() {
-	ThreadPoolClass = NewClass()
+	ThreadPoolClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
 	StdModule.AddConstantString("ThreadPool", Ref(ThreadPoolClass))
 	RegisterNativeClass("Std::ThreadPool", "value.ThreadPoolClass")
+
+	ThreadPoolErrorClass = NewClassWithOptions(ClassWithSuperclass(ErrorClass))
+	ThreadPoolClass.AddConstantString("Error", Ref(ThreadPoolErrorClass))
+	RegisterNativeClass("Std::ThreadPool::Error", "value.ThreadPoolErrorClass")
+
+	ThreadPoolClosedErrorClass = NewClassWithOptions(ClassWithSuperclass(ThreadPoolErrorClass))
+	ThreadPoolClass.AddConstantString("ClosedError", Ref(ThreadPoolClosedErrorClass))
+	RegisterNativeClass("Std::ThreadPool::ClosedError", "value.ThreadPoolClosedErrorClass")
 }
@@ -0,0 +1,89 @@
+package value
+
+import (
+	"fmt"
+	"time"
+)
+
+var TickerClass *Class // ::Std::Time::Ticker
+
+// Wraps a Go ticker that sends the current datetime
+// to its channel in regular intervals.
+type Ticker struct {
+	native *time.Ticker
+	ch     *NativeTransformerReadChannel[time.Time]
+}
+
+var _ Reference = &Ticker{}
+
+// Create a new ticker that fires every time the given time span elapses.
+// The span must be positive.
+func NewTicker(span TimeSpan) *Ticker {
+	native := time.NewTicker(span.Native())
+	return &Ticker{
+		native: native,
+		ch:     NewNativeTransformerReadChannel(native.C, timeToDateTimeValue),
+	}
+}
+
+// Create a read channel that receives the current datetime
+// every time the given time span elapses.
+// The span must be positive.
+func NewTickChannel(span TimeSpan) *NativeTransformerReadChannel[time.Time] {
+	return NewNativeTransformerReadChannel(time.Tick(span.Native()), timeToDateTimeValue)
+}
+
+func (t *Ticker) Copy() Reference {
+	return t
+}
+
+func (t *Ticker) ToValue() Value {
+	return Ref(t)
+}
+
+func (*Ticker) Class() *Class {
+	return TickerClass
+}
+
+func (*Ticker) DirectClass() *Class {
+	return TickerClass
+}
+
+func (*Ticker) SingletonClass() *Class {
+	return nil
+}
+
+func (t *Ticker) Inspect() string {
+	return fmt.Sprintf("Std::Time::Ticker{&: %p}", t)
+}
+
+func (t *Ticker) Error() string {
+	return t.Inspect()
+}
+
+func (*Ticker) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the channel that receives the datetime on every tick.
+func (t *Ticker) Channel() *NativeTransformerReadChannel[time.Time] {
+	return t.ch
+}
+
+// Stop the ticker.
+// No more ticks will be sent after the ticker is stopped.
+func (t *Ticker) Stop() {
+	t.native.Stop()
+}
+
+// Change the interval of the ticker to the given time span.
+// The span must be positive.
+func (t *Ticker) Reset(span TimeSpan) {
+	t.native.Reset(span.Native())
+}
+
+func initTicker() {
+	TickerClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	TimeClass.AddConstantString("Ticker", Ref(TickerClass))
+	RegisterNativeClass("Std::Time::Ticker", "value.TickerClass")
+}
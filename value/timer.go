@@ -0,0 +1,90 @@
+package value
+
+import (
+	"fmt"
+	"time"
+)
+
+var TimerClass *Class // ::Std::Time::Timer
+
+// Wraps a Go timer that sends the current datetime
+// to its channel after a time span.
+type Timer struct {
+	native *time.Timer
+	ch     *NativeTransformerReadChannel[time.Time]
+}
+
+var _ Reference = &Timer{}
+
+// Create a new timer that fires after the given time span.
+func NewTimer(span TimeSpan) *Timer {
+	native := time.NewTimer(span.Native())
+	return &Timer{
+		native: native,
+		ch:     NewNativeTransformerReadChannel(native.C, timeToDateTimeValue),
+	}
+}
+
+// Create a read channel that receives the current datetime after the given time span.
+func NewAfterChannel(span TimeSpan) *NativeTransformerReadChannel[time.Time] {
+	return NewNativeTransformerReadChannel(time.After(span.Native()), timeToDateTimeValue)
+}
+
+func timeToDateTimeValue(t time.Time) Value {
+	return Ref(ToElkDateTime(t))
+}
+
+func (t *Timer) Copy() Reference {
+	return t
+}
+
+func (t *Timer) ToValue() Value {
+	return Ref(t)
+}
+
+func (*Timer) Class() *Class {
+	return TimerClass
+}
+
+func (*Timer) DirectClass() *Class {
+	return TimerClass
+}
+
+func (*Timer) SingletonClass() *Class {
+	return nil
+}
+
+func (t *Timer) Inspect() string {
+	return fmt.Sprintf("Std::Time::Timer{&: %p}", t)
+}
+
+func (t *Timer) Error() string {
+	return t.Inspect()
+}
+
+func (*Timer) InstanceVariables() *InstanceVariables {
+	return nil
+}
+
+// Returns the channel that receives the datetime when the timer fires.
+func (t *Timer) Channel() *NativeTransformerReadChannel[time.Time] {
+	return t.ch
+}
+
+// Stop the timer.
+// Returns false when the timer has already fired or been stopped.
+func (t *Timer) Stop() bool {
+	return t.native.Stop()
+}
+
+// Change the timer to fire after the given time span.
+// Returns false when the timer has already fired or been stopped.
+func (t *Timer) Reset(span TimeSpan) bool {
+	return t.native.Reset(span.Native())
+}
+
+func initTimer() {
+	TimerClass = NewClassWithOptions(ClassWithConstructor(UndefinedConstructor))
+	TimeClass.AddConstantString("Timer", Ref(TimerClass))
+	RegisterNativeClass("Std::Time::Timer", "value.TimerClass")
+}
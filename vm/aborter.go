@@ -1,6 +1,8 @@
 package vm
 
 import (
+	"context"
+
 	"github.com/elk-language/elk/value"
 )
 
@@ -100,5 +102,21 @@ func initAborter() {
 			return value.Nil, value.Undefined
 		},
 	)
+}
+
+// Returns a context that is done when the aborter of the thread
+// or the given optional aborter gets closed.
+func (vm *Thread) abortContext(aborterValue value.Value) (context.Context, context.CancelFunc) {
+	ctx := vm.Aborter.Context()
+	if aborterValue.IsUndefined() || aborterValue.IsNil() {
+		return ctx, func() {}
+	}
 
+	aborter := aborterValue.AsReference().(*value.Aborter)
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(aborter.Context(), cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
 }
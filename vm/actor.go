@@ -0,0 +1,475 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Default number of messages that can wait in the mailbox of an actor.
+const DEFAULT_ACTOR_MAILBOX_SIZE = 64
+
+// Number of messages processed by an actor
+// before the thread worker is given back to the pool.
+const actorBatchSize = 64
+
+// A message sent to an actor.
+type actorMessage struct {
+	message value.Value
+	reply   *Promise // nil for messages sent with `tell`
+}
+
+// Settle the reply promise of the message.
+func (m actorMessage) settle(result, err value.Value, stackTrace *value.StackTrace) {
+	if m.reply == nil {
+		return
+	}
+	if !err.IsUndefined() {
+		m.reply.Reject(err, stackTrace)
+		return
+	}
+	m.reply.Resolve(result)
+}
+
+// ::Std::Actor
+//
+// Processes messages from its mailbox one at a time
+// in the workers of a thread pool.
+// An actor occupies a worker only when there are messages to process.
+type Actor struct {
+	mailbox    chan actorMessage
+	threadPool *ThreadPool
+	receive    value.Value
+	stateFn    value.Value // creates the state of the actor, undefined for stateless actors
+	state      value.Value // accessed only by the thread processing messages
+	supervisor *ActorSupervisor
+
+	scheduled atomic.Bool            // whether a task processing messages has been added to the pool
+	thread    atomic.Pointer[Thread] // thread processing messages, nil when the actor is idle
+	restart   atomic.Bool            // whether the state should be recreated before the next message
+	restarts  atomic.Int64
+	stopped   atomic.Bool // whether new messages are rejected
+	failed    atomic.Bool // whether messages left in the mailbox are rejected
+
+	m        sync.RWMutex  // held for reading by senders, for writing when the actor gets stopped
+	failing  chan struct{} // closed when the actor fails
+	failOnce sync.Once
+	done     chan struct{} // closed when the actor has stopped processing messages
+	doneOnce sync.Once
+
+	err        value.Value // error that stopped the actor
+	stackTrace *value.StackTrace
+}
+
+// Create a new actor.
+// `stateFn` should be undefined for stateless actors.
+func NewActor(threadPool *ThreadPool, mailboxSize int, receive, stateFn, state value.Value) *Actor {
+	return &Actor{
+		mailbox:    make(chan actorMessage, mailboxSize),
+		threadPool: threadPool,
+		receive:    receive,
+		stateFn:    stateFn,
+		state:      state,
+		failing:    make(chan struct{}),
+		done:       make(chan struct{}),
+		err:        value.Undefined,
+	}
+}
+
+func (*Actor) Class() *value.Class {
+	return value.ActorClass
+}
+
+func (*Actor) DirectClass() *value.Class {
+	return value.ActorClass
+}
+
+func (*Actor) SingletonClass() *value.Class {
+	return nil
+}
+
+func (a *Actor) Copy() value.Reference {
+	return a
+}
+
+func (a *Actor) ToValue() value.Value {
+	return value.Ref(a)
+}
+
+func (a *Actor) Inspect() string {
+	return fmt.Sprintf("Std::Actor{&: %p, stopped: %t, mailbox_length: %d}", a, a.IsStopped(), a.MailboxLength())
+}
+
+func (a *Actor) Error() string {
+	return a.Inspect()
+}
+
+func (*Actor) InstanceVariables() *value.InstanceVariables {
+	return nil
+}
+
+// Whether the actor no longer accepts new messages.
+func (a *Actor) IsStopped() bool {
+	return a.stopped.Load()
+}
+
+// Returns the number of messages waiting in the mailbox.
+func (a *Actor) MailboxLength() int {
+	return len(a.mailbox)
+}
+
+// Returns the number of times the actor has been restarted by its supervisor.
+func (a *Actor) RestartCount() int {
+	return int(a.restarts.Load())
+}
+
+func actorStoppedError() value.Value {
+	return value.Ref(value.NewError(value.ActorStoppedErrorClass, "actor is stopped"))
+}
+
+// Put a message in the mailbox.
+// Blocks while the mailbox is full,
+// unless the message is sent by the actor itself
+// which would never get to empty it.
+func (a *Actor) send(thread *Thread, ctx context.Context, message actorMessage) value.Value {
+	a.m.RLock()
+	defer a.m.RUnlock()
+
+	if a.stopped.Load() {
+		return actorStoppedError()
+	}
+	select {
+	case a.mailbox <- message:
+	default:
+		if a.thread.Load() == thread {
+			return value.Ref(value.NewError(
+				value.ActorMailboxFullErrorClass,
+				"actor cannot send a message to itself when its mailbox is full",
+			))
+		}
+
+		select {
+		case a.mailbox <- message:
+		case <-a.failing:
+			return actorStoppedError()
+		case <-ctx.Done():
+			return value.ExecutionAbortedError.ToValue()
+		}
+	}
+
+	a.schedule()
+	return value.Undefined
+}
+
+// Send a message without waiting for a reply.
+func (a *Actor) Tell(thread *Thread, ctx context.Context, message value.Value) value.Value {
+	return a.send(thread, ctx, actorMessage{message: message})
+}
+
+// Send a message and return a promise
+// that gets settled with the reply of the actor.
+func (a *Actor) Ask(thread *Thread, ctx context.Context, message value.Value) (*Promise, value.Value) {
+	reply := NewExternalPromise(a.threadPool)
+	if err := a.send(thread, ctx, actorMessage{message: message, reply: reply}); !err.IsUndefined() {
+		reply.Reject(err, nil)
+		return nil, err
+	}
+
+	return reply, value.Undefined
+}
+
+// Stop accepting new messages.
+// Messages that are already in the mailbox still get processed.
+func (a *Actor) Stop() {
+	a.m.Lock()
+	a.stopped.Store(true)
+	a.m.Unlock()
+
+	a.schedule()
+}
+
+// Stop the actor and reject messages left in the mailbox.
+func (a *Actor) fail(err value.Value, stackTrace *value.StackTrace) {
+	a.failOnce.Do(func() {
+		a.err = err
+		a.stackTrace = stackTrace
+		a.failed.Store(true)
+		close(a.failing)
+	})
+
+	a.Stop()
+}
+
+// Block until the actor has stopped and processed its messages.
+// Returns the error that made the actor fail.
+func (a *Actor) Wait(ctx context.Context) value.Value {
+	select {
+	case <-a.done:
+	case <-ctx.Done():
+		return value.ExecutionAbortedError.ToValue()
+	}
+
+	return a.err
+}
+
+// Recreate the state of the actor before the next message.
+func (a *Actor) requestRestart() {
+	a.restarts.Add(1)
+	a.restart.Store(true)
+}
+
+// Add a task processing messages to the thread pool
+// unless there already is one.
+func (a *Actor) schedule() {
+	if a.scheduled.CompareAndSwap(false, true) {
+		NewNativePromise(a.threadPool, a.process)
+	}
+}
+
+// Process a batch of messages from the mailbox.
+func (a *Actor) process(thread *Thread, _ []value.Value) (value.Value, value.Value) {
+	a.thread.Store(thread)
+	for range actorBatchSize {
+		select {
+		case message := <-a.mailbox:
+			a.handle(thread, message)
+		default:
+			a.thread.Store(nil)
+			a.idle()
+			return value.Nil, value.Undefined
+		}
+	}
+
+	// let other tasks run before processing the rest of the messages
+	a.thread.Store(nil)
+	a.scheduled.Store(false)
+	a.schedule()
+	return value.Nil, value.Undefined
+}
+
+// Called when the mailbox is empty.
+func (a *Actor) idle() {
+	a.scheduled.Store(false)
+	// a message could have been sent before the flag got cleared
+	if len(a.mailbox) > 0 {
+		a.schedule()
+		return
+	}
+
+	if a.stopped.Load() {
+		a.doneOnce.Do(func() {
+			close(a.done)
+		})
+	}
+}
+
+// Process a single message.
+func (a *Actor) handle(thread *Thread, message actorMessage) {
+	if a.failed.Load() {
+		message.settle(value.Undefined, actorStoppedError(), nil)
+		return
+	}
+
+	thread.errStackTrace = nil
+	if a.restart.Swap(false) && !a.stateFn.IsUndefined() {
+		state, err := thread.CallCallable(a.stateFn)
+		if !err.IsUndefined() {
+			a.handleFailure(thread, message, err)
+			return
+		}
+		a.state = state
+	}
+
+	var result, err value.Value
+	if a.stateFn.IsUndefined() {
+		result, err = thread.CallCallable(a.receive, message.message)
+	} else {
+		result, err = thread.CallCallable(a.receive, a.state, message.message)
+	}
+	if !err.IsUndefined() {
+		a.handleFailure(thread, message, err)
+		return
+	}
+
+	message.settle(result, value.Undefined, nil)
+}
+
+// Reject the message and restart or stop the actor.
+func (a *Actor) handleFailure(thread *Thread, message actorMessage, err value.Value) {
+	stackTrace := thread.errStackTrace
+	thread.errStackTrace = nil
+	message.settle(value.Undefined, err, stackTrace)
+
+	if a.supervisor != nil && a.supervisor.childFailed(a, err, stackTrace) {
+		return
+	}
+	a.fail(err, stackTrace)
+}
+
+// Returns the thread pool, mailbox size and supervisor
+// given as optional arguments.
+func actorOptions(vm *Thread, args []value.Value) (*ThreadPool, int, *ActorSupervisor, value.Value) {
+	mailboxSize := DEFAULT_ACTOR_MAILBOX_SIZE
+	if !args[0].IsUndefined() && !args[0].IsNil() {
+		n, ok := value.ToGoInt(args[0])
+		if !ok || n < 1 {
+			return nil, 0, nil, value.Ref(value.NewError(
+				value.OutOfRangeErrorClass,
+				fmt.Sprintf("invalid mailbox size: %s", args[0].Inspect()),
+			))
+		}
+		mailboxSize = n
+	}
+
+	threadPool := vm.threadPool
+	if !args[1].IsUndefined() && !args[1].IsNil() {
+		threadPool = (*ThreadPool)(args[1].Pointer())
+	}
+
+	var supervisor *ActorSupervisor
+	if !args[2].IsUndefined() && !args[2].IsNil() {
+		supervisor = (*ActorSupervisor)(args[2].Pointer())
+	}
+
+	return threadPool, mailboxSize, supervisor, value.Undefined
+}
+
+// Register the actor in its supervisor.
+func superviseActor(actor *Actor, supervisor *ActorSupervisor) value.Value {
+	if supervisor == nil {
+		return value.Undefined
+	}
+
+	actor.supervisor = supervisor
+	return supervisor.addChild(actor)
+}
+
+// ::Std::Actor
+func initActor() {
+	// Singleton methods
+	c := &value.ActorClass.SingletonClass().MethodContainer
+	Def(
+		c,
+		"spawn",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			stateFn := args[1]
+			receive := args[2]
+			threadPool, mailboxSize, supervisor, err := actorOptions(vm, args[3:])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			state, err := vm.CallCallable(stateFn)
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			actor := NewActor(threadPool, mailboxSize, receive, stateFn, state)
+			if err := superviseActor(actor, supervisor); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Ref(actor), value.Undefined
+		},
+		DefWithParameters(5),
+	)
+
+	// Instance methods
+	c = &value.ActorClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			receive := args[1]
+			threadPool, mailboxSize, supervisor, err := actorOptions(vm, args[2:])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			actor := NewActor(threadPool, mailboxSize, receive, value.Undefined, value.Undefined)
+			if err := superviseActor(actor, supervisor); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Ref(actor), value.Undefined
+		},
+		DefWithParameters(4),
+	)
+	Def(
+		c,
+		"tell",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Actor)(args[0].Pointer())
+			if err := self.Tell(vm, vm.Aborter.Context(), args[1]); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return args[0], value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Alias(c, "<<", "tell")
+	Def(
+		c,
+		"ask",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Actor)(args[0].Pointer())
+			reply, err := self.Ask(vm, vm.Aborter.Context(), args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Ref(reply), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"stop",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Actor)(args[0].Pointer())
+			self.Stop()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Actor)(args[0].Pointer())
+			ctx, cancel := vm.abortContext(args[1])
+			defer cancel()
+
+			if err := self.Wait(ctx); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"is_stopped",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Actor)(args[0].Pointer())
+			return value.BoolVal(self.IsStopped()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"mailbox_length",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Actor)(args[0].Pointer())
+			return value.SmallInt(self.MailboxLength()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"restart_count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Actor)(args[0].Pointer())
+			return value.SmallInt(self.RestartCount()).ToValue(), value.Undefined
+		},
+	)
+
+	initActorSupervisor()
+}
@@ -0,0 +1,292 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
+)
+
+// Default maximum number of restarts within the supervision window.
+const DEFAULT_SUPERVISOR_MAX_RESTARTS = 3
+
+// Default supervision window.
+const DEFAULT_SUPERVISOR_WITHIN = 5 * time.Second
+
+// ::Std::Actor::Supervisor
+//
+// Restarts actors that fail while processing a message.
+// When actors fail more than `maxRestarts` times within
+// the supervision window, all of them get stopped.
+type ActorSupervisor struct {
+	oneForAll   bool // whether the failure of a child restarts all children
+	maxRestarts int
+	within      time.Duration
+
+	m          sync.Mutex
+	children   []*Actor
+	restarts   []time.Time // moments of recent restarts
+	failed     bool
+	err        value.Value
+	stackTrace *value.StackTrace
+}
+
+// Create a new supervisor.
+func NewActorSupervisor(oneForAll bool, maxRestarts int, within time.Duration) *ActorSupervisor {
+	return &ActorSupervisor{
+		oneForAll:   oneForAll,
+		maxRestarts: maxRestarts,
+		within:      within,
+		err:         value.Undefined,
+	}
+}
+
+func (*ActorSupervisor) Class() *value.Class {
+	return value.ActorSupervisorClass
+}
+
+func (*ActorSupervisor) DirectClass() *value.Class {
+	return value.ActorSupervisorClass
+}
+
+func (*ActorSupervisor) SingletonClass() *value.Class {
+	return nil
+}
+
+func (s *ActorSupervisor) Copy() value.Reference {
+	return s
+}
+
+func (s *ActorSupervisor) ToValue() value.Value {
+	return value.Ref(s)
+}
+
+func (s *ActorSupervisor) Inspect() string {
+	return fmt.Sprintf(
+		"Std::Actor::Supervisor{&: %p, strategy: %s, max_restarts: %d, within: %s}",
+		s,
+		s.Strategy().Inspect(),
+		s.maxRestarts,
+		value.TimeSpan(s.within).Inspect(),
+	)
+}
+
+func (s *ActorSupervisor) Error() string {
+	return s.Inspect()
+}
+
+func (*ActorSupervisor) InstanceVariables() *value.InstanceVariables {
+	return nil
+}
+
+// Returns the name of the restart strategy.
+func (s *ActorSupervisor) Strategy() value.Symbol {
+	if s.oneForAll {
+		return symbol.L_one_for_all
+	}
+	return symbol.L_one_for_one
+}
+
+// Returns the supervised actors.
+func (s *ActorSupervisor) Children() []*Actor {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return slices.Clone(s.children)
+}
+
+// Register a new child.
+// Returns an error when the supervisor has already failed.
+func (s *ActorSupervisor) addChild(actor *Actor) value.Value {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.failed {
+		return value.Ref(value.NewError(value.ActorStoppedErrorClass, "supervisor is stopped"))
+	}
+	s.children = append(s.children, actor)
+	return value.Undefined
+}
+
+// Called by a child that has failed.
+// Reports whether the child gets restarted,
+// otherwise the supervisor stops all of its children.
+func (s *ActorSupervisor) childFailed(child *Actor, err value.Value, stackTrace *value.StackTrace) bool {
+	s.m.Lock()
+	if s.failed {
+		s.m.Unlock()
+		return false
+	}
+
+	// forget restarts that happened outside of the window
+	now := time.Now()
+	s.restarts = slices.DeleteFunc(s.restarts, func(restart time.Time) bool {
+		return now.Sub(restart) >= s.within
+	})
+	if len(s.restarts) >= s.maxRestarts {
+		s.failed = true
+		s.err = err
+		s.stackTrace = stackTrace
+		children := slices.Clone(s.children)
+		s.m.Unlock()
+
+		for _, sibling := range children {
+			if sibling != child {
+				sibling.fail(err, stackTrace)
+			}
+		}
+		return false
+	}
+
+	s.restarts = append(s.restarts, now)
+	restarted := []*Actor{child}
+	if s.oneForAll {
+		restarted = slices.Clone(s.children)
+	}
+	s.m.Unlock()
+
+	for _, actor := range restarted {
+		actor.requestRestart()
+	}
+	return true
+}
+
+// Stop all children.
+func (s *ActorSupervisor) Stop() {
+	for _, child := range s.Children() {
+		child.Stop()
+	}
+}
+
+// Block until all children have stopped.
+// Returns the error that made the supervisor give up.
+func (s *ActorSupervisor) Wait(ctx context.Context) value.Value {
+	for _, child := range s.Children() {
+		select {
+		case <-child.done:
+		case <-ctx.Done():
+			return value.ExecutionAbortedError.ToValue()
+		}
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.err
+}
+
+// ::Std::Actor::Supervisor
+func initActorSupervisor() {
+	// Instance methods
+	c := &value.ActorSupervisorClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			var oneForAll bool
+			if !args[1].IsUndefined() {
+				switch args[1].AsInlineSymbol() {
+				case symbol.L_one_for_one:
+				case symbol.L_one_for_all:
+					oneForAll = true
+				default:
+					return value.Undefined, value.Ref(value.NewError(
+						value.OutOfRangeErrorClass,
+						fmt.Sprintf("invalid supervision strategy: %s", args[1].Inspect()),
+					))
+				}
+			}
+
+			maxRestarts := DEFAULT_SUPERVISOR_MAX_RESTARTS
+			if !args[2].IsUndefined() {
+				n, ok := value.ToGoInt(args[2])
+				if !ok || n < 0 {
+					return value.Undefined, value.Ref(value.NewError(
+						value.OutOfRangeErrorClass,
+						fmt.Sprintf("invalid max restart count: %s", args[2].Inspect()),
+					))
+				}
+				maxRestarts = n
+			}
+
+			within := DEFAULT_SUPERVISOR_WITHIN
+			if !args[3].IsUndefined() && !args[3].IsNil() {
+				within = args[3].AsTimeSpan().Native()
+				if within <= 0 {
+					return value.Undefined, value.Ref(value.NewError(
+						value.OutOfRangeErrorClass,
+						fmt.Sprintf("invalid supervision window: %s", args[3].Inspect()),
+					))
+				}
+			}
+
+			return value.Ref(NewActorSupervisor(oneForAll, maxRestarts, within)), value.Undefined
+		},
+		DefWithParameters(3),
+	)
+	Def(
+		c,
+		"strategy",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ActorSupervisor)(args[0].Pointer())
+			return self.Strategy().ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"max_restarts",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ActorSupervisor)(args[0].Pointer())
+			return value.SmallInt(self.maxRestarts).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"within",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ActorSupervisor)(args[0].Pointer())
+			return value.TimeSpan(self.within).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"children",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ActorSupervisor)(args[0].Pointer())
+			children := self.Children()
+			list := value.NewArrayListOfValue(len(children))
+			for _, child := range children {
+				list.Append(value.Ref(child))
+			}
+			return value.Ref(list), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stop",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ActorSupervisor)(args[0].Pointer())
+			self.Stop()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ActorSupervisor)(args[0].Pointer())
+			ctx, cancel := vm.abortContext(args[1])
+			defer cancel()
+
+			if err := self.Wait(ctx); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
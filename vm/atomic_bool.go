@@ -0,0 +1,55 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::AtomicBool
+func initAtomicBool() {
+	// Instance methods
+	c := &value.AtomicBoolClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.NewAtomicBool(args[1].IsTrue())), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"load",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicBool)(args[0].Pointer())
+			return value.BoolVal(self.Load()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"store",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicBool)(args[0].Pointer())
+			self.Store(args[1].IsTrue())
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicBool)(args[0].Pointer())
+			return value.BoolVal(self.Swap(args[1].IsTrue())), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"compare_and_swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicBool)(args[0].Pointer())
+			return value.BoolVal(self.CompareAndSwap(args[1].IsTrue(), args[2].IsTrue())), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+}
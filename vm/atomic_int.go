@@ -0,0 +1,120 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Convert an Elk value to an int64 that can be stored in an atomic int.
+func toAtomicInt(val value.Value) (int64, value.Value) {
+	i, ok := value.ToGoInt(val)
+	if !ok {
+		return 0, value.Ref(value.NewError(
+			value.OutOfRangeErrorClass,
+			fmt.Sprintf("%s is too large for an atomic int", val.Inspect()),
+		))
+	}
+
+	return int64(i), value.Undefined
+}
+
+// Std::Sync::AtomicInt
+func initAtomicInt() {
+	// Instance methods
+	c := &value.AtomicIntClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			if args[1].IsUndefined() {
+				return value.Ref(value.NewAtomicInt(0)), value.Undefined
+			}
+			i, err := toAtomicInt(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Ref(value.NewAtomicInt(i)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"load",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicInt)(args[0].Pointer())
+			return value.ToElkInt(self.Load()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"store",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicInt)(args[0].Pointer())
+			i, err := toAtomicInt(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			self.Store(i)
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicInt)(args[0].Pointer())
+			i, err := toAtomicInt(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.ToElkInt(self.Swap(i)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"compare_and_swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicInt)(args[0].Pointer())
+			expected, err := toAtomicInt(args[1])
+			if !err.IsUndefined() {
+				// a value that does not fit in an atomic int is never equal to the current one
+				return value.False.ToValue(), value.Undefined
+			}
+			val, err := toAtomicInt(args[2])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.BoolVal(self.CompareAndSwap(expected, val)), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"add",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicInt)(args[0].Pointer())
+			delta, err := toAtomicInt(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.ToElkInt(self.Add(delta)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"sub",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicInt)(args[0].Pointer())
+			delta, err := toAtomicInt(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.ToElkInt(self.Sub(delta)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
@@ -0,0 +1,55 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::AtomicRef
+func initAtomicRef() {
+	// Instance methods
+	c := &value.AtomicRefClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.NewAtomicRef(args[1])), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"load",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicRef)(args[0].Pointer())
+			return self.Load(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"store",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicRef)(args[0].Pointer())
+			self.Store(args[1])
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicRef)(args[0].Pointer())
+			return self.Swap(args[1]), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"compare_and_swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicRef)(args[0].Pointer())
+			return value.BoolVal(self.CompareAndSwap(args[1], args[2])), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+}
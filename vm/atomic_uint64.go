@@ -0,0 +1,76 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::AtomicUInt64
+func initAtomicUInt64() {
+	// Instance methods
+	c := &value.AtomicUInt64Class.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			if args[1].IsUndefined() {
+				return value.Ref(value.NewAtomicUInt64(0)), value.Undefined
+			}
+			return value.Ref(value.NewAtomicUInt64(args[1].AsUInt64())), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"load",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicUInt64)(args[0].Pointer())
+			return self.Load().ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"store",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicUInt64)(args[0].Pointer())
+			self.Store(args[1].AsUInt64())
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicUInt64)(args[0].Pointer())
+			return self.Swap(args[1].AsUInt64()).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"compare_and_swap",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicUInt64)(args[0].Pointer())
+			return value.BoolVal(self.CompareAndSwap(args[1].AsUInt64(), args[2].AsUInt64())), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"add",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicUInt64)(args[0].Pointer())
+			return self.Add(args[1].AsUInt64()).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"sub",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.AtomicUInt64)(args[0].Pointer())
+			return self.Sub(args[1].AsUInt64()).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
@@ -0,0 +1,60 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::Barrier
+func initBarrier() {
+	// Instance methods
+	c := &value.BarrierClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			parties, ok := value.ToGoInt(args[1])
+			if !ok || parties < 1 {
+				return value.Undefined, value.Ref(value.NewError(
+					value.OutOfRangeErrorClass,
+					fmt.Sprintf("invalid barrier party count: %s", args[1].Inspect()),
+				))
+			}
+			return value.Ref(value.NewBarrier(parties)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"parties",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Barrier)(args[0].Pointer())
+			return value.SmallInt(self.Parties()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"waiting_count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Barrier)(args[0].Pointer())
+			return value.SmallInt(self.WaitingCount()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Barrier)(args[0].Pointer())
+			ctx, cancel := vm.abortContext(args[1])
+			defer cancel()
+
+			last, err := self.Wait(ctx)
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.BoolVal(last), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
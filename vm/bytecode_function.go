@@ -9,6 +9,7 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/elk-language/elk/bitfield"
@@ -27,10 +28,12 @@ type BytecodeFunction struct {
 	Doc          value.Value
 	CatchEntries []*CatchEntry
 	UpvalueCount int
+	GlobalEnv    any // type information of the program that contains the function, set by the type checker
 
 	name                   value.Symbol
 	parameterCount         int
 	optionalParameterCount int
+	replacement            atomic.Pointer[BytecodeFunction] // the new version of the method after hot reloading
 }
 
 func (b *BytecodeFunction) ipAddRaw(n uintptr) uintptr {
@@ -12,14 +12,23 @@ type CallSiteInfo struct {
 	Name          value.Symbol
 	ArgumentCount int
 	Cache         [3]CallCacheEntry
+	epoch         uint64 // call cache epoch in which the cache has been populated
 }
 
 type CallCache struct {
 	Entries [3]CallCacheEntry
+	epoch   uint64 // call cache epoch in which the cache has been populated
 }
 
 func LookupMethodInCache(class *value.Class, name value.Symbol, cacheLoc **CallCache) value.Method {
 	cache := *cacheLoc
+	var epoch uint64
+	if hotReloadEnabled {
+		epoch = callCacheEpoch.Load()
+		if cache.epoch != epoch {
+			cache = &CallCache{epoch: epoch}
+		}
+	}
 	for i := range len(cache.Entries) {
 		cacheEntry := cache.Entries[i]
 		if cacheEntry.Class == class {
@@ -34,6 +43,7 @@ func LookupMethodInCache(class *value.Class, name value.Symbol, cacheLoc **CallC
 			}
 			*cacheLoc = &CallCache{
 				Entries: newEntries,
+				epoch:   epoch,
 			}
 			return method
 		}
@@ -17,6 +17,27 @@ func initChannel() {
 			return value.Ref(ch), value.Undefined
 		},
 	)
+	Def(
+		c,
+		"after",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			span := args[1].AsTimeSpan()
+			return value.Ref(value.NewAfterChannel(span)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"tick",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			span := args[1].AsTimeSpan()
+			if err := checkTickInterval(span); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Ref(value.NewTickChannel(span)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
 
 	// Instance methods
 	c = &value.ChannelClass.MethodContainer
@@ -85,7 +106,9 @@ func initChannel() {
 		"<<",
 		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
 			self := args[0].AsReference().(value.Channel)
+			vm.blockOnChannel(self, "Std::Channel#push")
 			err := self.PushCtx(vm.Aborter.Context(), args[1])
+			vm.unblock()
 			if err.IsUndefined() {
 				return self.ToValue(), value.Undefined
 			}
@@ -100,7 +123,9 @@ func initChannel() {
 		"pop",
 		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
 			self := args[0].AsReference().(value.Channel)
+			vm.blockOnChannel(self, "Std::Channel#pop")
 			result, err := self.PopCtx(vm.Aborter.Context())
+			vm.unblock()
 			if err.IsNotUndefined() {
 				return value.Undefined, err
 			}
@@ -113,7 +138,10 @@ func initChannel() {
 		"<<@",
 		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
 			self := args[0].AsReference().(value.Channel)
-			return value.MakeResult2(self.PopCtx(vm.Aborter.Context())).ToValue(), value.Undefined
+			vm.blockOnChannel(self, "Std::Channel#<<@")
+			result := value.MakeResult2(self.PopCtx(vm.Aborter.Context()))
+			vm.unblock()
+			return result.ToValue(), value.Undefined
 		},
 	)
 
@@ -154,7 +182,10 @@ func initChannel() {
 		"next",
 		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
 			self := args[0].AsReference().(value.Channel)
-			return self.NextValueCtx(vm.Aborter.Context())
+			vm.blockOnChannel(self, "Std::Channel#next")
+			result, err := self.NextValueCtx(vm.Aborter.Context())
+			vm.unblock()
+			return result, err
 		},
 	)
 	Def(
@@ -0,0 +1,61 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::Cond
+func initCond() {
+	// Instance methods
+	c := &value.CondClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			mutex := (*value.Mutex)(args[1].Pointer())
+			return value.Ref(value.NewCond(mutex)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"mutex",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Cond)(args[0].Pointer())
+			return value.Ref(self.Mutex), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Cond)(args[0].Pointer())
+			ctx, cancel := vm.abortContext(args[1])
+			defer cancel()
+
+			if err := self.Wait(ctx); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"signal",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Cond)(args[0].Pointer())
+			self.Signal()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"broadcast",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Cond)(args[0].Pointer())
+			self.Broadcast()
+			return value.Nil, value.Undefined
+		},
+	)
+}
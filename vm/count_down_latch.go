@@ -0,0 +1,60 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::CountDownLatch
+func initCountDownLatch() {
+	// Instance methods
+	c := &value.CountDownLatchClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			count, ok := value.ToGoInt(args[1])
+			if !ok || count < 0 {
+				return value.Undefined, value.Ref(value.NewError(
+					value.OutOfRangeErrorClass,
+					fmt.Sprintf("invalid latch count: %s", args[1].Inspect()),
+				))
+			}
+			return value.Ref(value.NewCountDownLatch(count)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.CountDownLatch)(args[0].Pointer())
+			return value.SmallInt(self.Count()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"count_down",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.CountDownLatch)(args[0].Pointer())
+			self.CountDown()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.CountDownLatch)(args[0].Pointer())
+			ctx, cancel := vm.abortContext(args[1])
+			defer cancel()
+
+			if err := self.Wait(ctx); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
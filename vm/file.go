@@ -0,0 +1,251 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
+)
+
+// Std::FS::File
+func initFile() {
+	// Instance methods
+	c := &value.FileClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			mode := value.FileModeRead
+			if !args[2].IsUndefined() && !args[2].IsNil() {
+				var ok bool
+				mode, ok = value.FileModeFromName(args[2].AsInlineSymbol().String())
+				if !ok {
+					return value.Undefined, value.Ref(value.NewError(
+						value.OutOfRangeErrorClass,
+						fmt.Sprintf("invalid file mode: %s", args[2].Inspect()),
+					))
+				}
+			}
+			perm, errVal := permissionsArgument(args[3], DEFAULT_FILE_PERMISSIONS)
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+
+			file, err := value.OpenFile(pathArgument(args[1]), mode, perm)
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(file), value.Undefined
+		},
+		DefWithParameters(3),
+	)
+	Def(
+		c,
+		"path",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			return value.Ref(value.NewPath(self.Path)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"mode",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			return value.ToSymbol(self.Mode.String()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stat",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			info, err := self.Stat()
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(info), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"read_line",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			line, ok, err := self.ReadLine()
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			if !ok {
+				return value.Nil, value.Undefined
+			}
+			return value.Ref(value.String(line)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"read",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			limit, errVal := readLimitArgument(args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			content, err := value.ReadString(self, limit)
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(value.String(content)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"write",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			n, err := self.WriteString(string(args[1].AsString()))
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.SmallInt(n).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"flush",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			if err := self.Flush(); err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"close",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			if err := self.Close(); err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_closed",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			return value.BoolVal(self.IsClosed()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"iter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return args[0], value.Undefined
+		},
+	)
+	Def(
+		c,
+		"next",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			line, ok, err := self.ReadLine()
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			if !ok {
+				return value.Undefined, symbol.L_stop_iteration.ToValue()
+			}
+			return value.Ref(value.String(line)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"each_line",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.File)(args[0].Pointer())
+			fn := args[1]
+			for {
+				line, ok, err := self.ReadLine()
+				if err != nil {
+					return value.Undefined, value.NewFSError(err)
+				}
+				if !ok {
+					return value.Nil, value.Undefined
+				}
+				_, errVal := vm.CallCallable(fn, value.Ref(value.String(line)))
+				if !errVal.IsUndefined() {
+					return value.Undefined, errVal
+				}
+			}
+		},
+		DefWithParameters(1),
+	)
+}
+
+// Std::FS::FileInfo
+func initFileInfo() {
+	// Instance methods
+	c := &value.FileInfoClass.MethodContainer
+	Def(
+		c,
+		"name",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FileInfo)(args[0].Pointer())
+			return value.Ref(value.String(self.Native.Name())), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"size",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FileInfo)(args[0].Pointer())
+			return value.ToElkInt(self.Native.Size()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"mode",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FileInfo)(args[0].Pointer())
+			return value.ToElkInt(self.Permissions()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"mtime",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FileInfo)(args[0].Pointer())
+			return value.Ref(value.ToElkDateTime(self.Native.ModTime())), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_dir",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FileInfo)(args[0].Pointer())
+			return value.BoolVal(self.Native.IsDir()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_file",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FileInfo)(args[0].Pointer())
+			return value.BoolVal(self.IsFile()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_symlink",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FileInfo)(args[0].Pointer())
+			return value.BoolVal(self.IsSymlink()), value.Undefined
+		},
+	)
+}
@@ -0,0 +1,227 @@
+package vm
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/formatscanner"
+)
+
+// Create a string formatted according to the given format string
+// with specifiers like `%-10s` or `%08.3f`.
+func Format(vm *Thread, format string, args []value.Value) (value.String, value.Value) {
+	scanner := formatscanner.New(format)
+	var buffer strings.Builder
+	var argIndex int
+
+tokenLoop:
+	for {
+		token, spec := scanner.Next()
+		switch token {
+		case formatscanner.END_OF_FILE:
+			break tokenLoop
+		case formatscanner.INVALID_SPECIFIER:
+			return "", value.Ref(value.Errorf(
+				value.FormatErrorClass,
+				"invalid format specifier: %s",
+				spec.Value,
+			))
+		case formatscanner.PERCENT:
+			buffer.WriteByte('%')
+		case formatscanner.TEXT:
+			buffer.WriteString(spec.Value)
+		case formatscanner.SPECIFIER:
+			if argIndex >= len(args) {
+				return "", value.Ref(value.Errorf(
+					value.FormatErrorClass,
+					"missing argument for format specifier `%s`",
+					spec.Value,
+				))
+			}
+			err := formatArgument(vm, &buffer, spec, args[argIndex])
+			if !err.IsUndefined() {
+				return "", err
+			}
+			argIndex++
+		}
+	}
+
+	if argIndex < len(args) {
+		return "", value.Ref(value.Errorf(
+			value.FormatErrorClass,
+			"too many arguments for format string, expected %d, got %d",
+			argIndex,
+			len(args),
+		))
+	}
+
+	return value.String(buffer.String()), value.Undefined
+}
+
+// Write a single formatted argument to the buffer.
+func formatArgument(vm *Thread, buffer *strings.Builder, spec formatscanner.Specifier, arg value.Value) value.Value {
+	var goVal any
+	verb := spec.Verb
+	switch verb {
+	case 's':
+		result, err := ToString(vm, arg)
+		if !err.IsUndefined() {
+			return err
+		}
+		goVal = result.AsString().String()
+	case 'p':
+		result, err := Inspect(vm, arg)
+		if !err.IsUndefined() {
+			return err
+		}
+		goVal = result.AsString().String()
+		verb = 's'
+	case 'd', 'i', 'x', 'X', 'o', 'b':
+		i, ok := formatInt(arg)
+		if !ok {
+			return formatTypeError(spec, "an integer", arg)
+		}
+		goVal = i
+		switch verb {
+		case 'i':
+			verb = 'd'
+		case 'o':
+			if spec.Hash {
+				// `0o` prefix
+				verb = 'O'
+				spec.Hash = false
+			}
+		}
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		f, ok := formatFloat(arg)
+		if !ok {
+			return formatTypeError(spec, "a number", arg)
+		}
+		goVal = f
+	case 'c':
+		if !arg.IsChar() {
+			return formatTypeError(spec, "a char", arg)
+		}
+		goVal = rune(arg.AsChar())
+	}
+
+	fmt.Fprintf(buffer, goFormat(spec, verb), goVal)
+	return value.Undefined
+}
+
+func formatTypeError(spec formatscanner.Specifier, expected string, arg value.Value) value.Value {
+	return value.Ref(value.Errorf(
+		value.TypeErrorClass,
+		"format specifier `%s` expects %s, got `%s`",
+		spec.Value,
+		expected,
+		arg.Class().PrintableName(),
+	))
+}
+
+// Build a Go format string for a single specifier.
+func goFormat(spec formatscanner.Specifier, verb rune) string {
+	var buff strings.Builder
+	buff.WriteByte('%')
+	if spec.Minus {
+		buff.WriteByte('-')
+	}
+	if spec.Plus {
+		buff.WriteByte('+')
+	}
+	if spec.Space {
+		buff.WriteByte(' ')
+	}
+	if spec.Zero {
+		buff.WriteByte('0')
+	}
+	if spec.Hash {
+		buff.WriteByte('#')
+	}
+	if spec.Width != -1 {
+		buff.WriteString(strconv.Itoa(spec.Width))
+	}
+	if spec.Precision != -1 {
+		buff.WriteByte('.')
+		buff.WriteString(strconv.Itoa(spec.Precision))
+	}
+	buff.WriteRune(verb)
+	return buff.String()
+}
+
+// Converts an Elk integer to a Go value that can be formatted.
+func formatInt(val value.Value) (any, bool) {
+	if val.IsReference() {
+		switch v := val.AsReference().(type) {
+		case *value.BigInt:
+			return v.ToGoBigInt(), true
+		case value.Int64:
+			return int64(v), true
+		case value.UInt64:
+			return uint64(v), true
+		}
+		return nil, false
+	}
+
+	switch val.ValueFlag() {
+	case value.SMALL_INT_FLAG:
+		return int64(val.AsSmallInt()), true
+	case value.INT8_FLAG:
+		return int64(val.AsInt8()), true
+	case value.INT16_FLAG:
+		return int64(val.AsInt16()), true
+	case value.INT32_FLAG:
+		return int64(val.AsInt32()), true
+	case value.INT64_FLAG:
+		return int64(val.AsInlineInt64()), true
+	case value.UINT8_FLAG:
+		return uint64(val.AsUInt8()), true
+	case value.UINT16_FLAG:
+		return uint64(val.AsUInt16()), true
+	case value.UINT32_FLAG:
+		return uint64(val.AsUInt32()), true
+	case value.UINT64_FLAG:
+		return uint64(val.AsInlineUInt64()), true
+	case value.UINT_FLAG:
+		return uint64(val.AsUInt()), true
+	}
+	return nil, false
+}
+
+// Converts an Elk number to a Go floating point value that can be formatted.
+func formatFloat(val value.Value) (any, bool) {
+	if val.IsReference() {
+		switch v := val.AsReference().(type) {
+		case *value.BigFloat:
+			return v.AsGoBigFloat(), true
+		case value.Float64:
+			return float64(v), true
+		}
+	} else {
+		switch val.ValueFlag() {
+		case value.FLOAT_FLAG:
+			return float64(val.AsFloat()), true
+		case value.FLOAT64_FLAG:
+			return float64(val.AsInlineFloat64()), true
+		case value.FLOAT32_FLAG:
+			return float64(val.AsFloat32()), true
+		}
+	}
+
+	i, ok := formatInt(val)
+	if !ok {
+		return nil, false
+	}
+	switch i := i.(type) {
+	case int64:
+		return float64(i), true
+	case uint64:
+		return float64(i), true
+	case *big.Int:
+		return new(big.Float).SetInt(i), true
+	}
+	return nil, false
+}
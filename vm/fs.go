@@ -0,0 +1,360 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/elk-language/elk/value"
+)
+
+const (
+	DEFAULT_FILE_PERMISSIONS      = 0o644
+	DEFAULT_DIRECTORY_PERMISSIONS = 0o755
+)
+
+var errIsDirectory = errors.New("is a directory")
+
+// Std::FS
+func initFS() {
+	c := &value.FSModule.SingletonClass().MethodContainer
+	Def(
+		c,
+		"read",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return readFile(pathArgument(args[1]))
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"read_async",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			path := pathArgument(args[1])
+			p := vm.SubmitIO(func(context.Context) (value.Value, value.Value) {
+				return readFile(path)
+			})
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"write",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			perm, errVal := permissionsArgument(args[3], DEFAULT_FILE_PERMISSIONS)
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			return writeFile(pathArgument(args[1]), args[2].AsString().String(), perm)
+		},
+		DefWithParameters(3),
+	)
+	Def(
+		c,
+		"write_async",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			perm, errVal := permissionsArgument(args[3], DEFAULT_FILE_PERMISSIONS)
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			path := pathArgument(args[1])
+			content := args[2].AsString().String()
+			p := vm.SubmitIO(func(context.Context) (value.Value, value.Value) {
+				return writeFile(path, content, perm)
+			})
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(4),
+	)
+	Def(
+		c,
+		"append",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			perm, errVal := permissionsArgument(args[3], DEFAULT_FILE_PERMISSIONS)
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			file, err := os.OpenFile(pathArgument(args[1]), os.O_WRONLY|os.O_CREATE|os.O_APPEND, perm)
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			_, err = file.WriteString(string(args[2].AsString()))
+			closeErr := file.Close()
+			if err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(3),
+	)
+	Def(
+		c,
+		"exists",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			_, err := os.Lstat(pathArgument(args[1]))
+			return value.BoolVal(err == nil), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"stat",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			info, err := os.Stat(pathArgument(args[1]))
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(value.NewFileInfo(info)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"lstat",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			info, err := os.Lstat(pathArgument(args[1]))
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(value.NewFileInfo(info)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"mkdir_all",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			perm, errVal := permissionsArgument(args[2], DEFAULT_DIRECTORY_PERMISSIONS)
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			err := os.MkdirAll(pathArgument(args[1]), perm)
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"make_temp_dir",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			var prefix string
+			if !args[1].IsUndefined() && !args[1].IsNil() {
+				prefix = strings.ReplaceAll(string(args[1].AsString()), "*", "")
+			}
+			dir, err := os.MkdirTemp("", prefix+"*")
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(value.NewPath(dir)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"remove",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			path := pathArgument(args[1])
+			var err error
+			if value.Truthy(args[2]) {
+				err = os.RemoveAll(path)
+			} else {
+				err = os.Remove(path)
+			}
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"rename",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			err := os.Rename(pathArgument(args[1]), pathArgument(args[2]))
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"copy@1",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			err := copyFile(pathArgument(args[1]), pathArgument(args[2]))
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"list_dir",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			entries, err := os.ReadDir(pathArgument(args[1]))
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+
+			list := value.NewArrayListOfValue(len(entries))
+			for _, entry := range entries {
+				info, err := entry.Info()
+				if err != nil {
+					return value.Undefined, value.NewFSError(err)
+				}
+				list.Append(value.Ref(value.NewFileInfo(info)))
+			}
+			return value.Ref(list), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"walk",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			symlinks, ignore, errVal := walkOptions(vm, args[2], args[3])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			walker, err := value.NewFSWalker(pathArgument(args[1]), symlinks, ignore)
+			if err != nil {
+				return value.Undefined, value.Ref(value.NewError(value.GlobErrorClass, err.Error()))
+			}
+			return value.Ref(walker), value.Undefined
+		},
+		DefWithParameters(3),
+	)
+	Def(
+		c,
+		"glob",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			symlinks, ignore, errVal := walkOptions(vm, args[2], args[3])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			pattern := string(args[1].AsString())
+			glob, err := value.NewFSGlobIterator(pattern, symlinks, ignore)
+			if err != nil {
+				return value.Undefined, value.Ref(value.NewError(value.GlobErrorClass, err.Error()))
+			}
+			return value.Ref(glob), value.Undefined
+		},
+		DefWithParameters(3),
+	)
+}
+
+// Returns the content of the file.
+func readFile(path string) (value.Value, value.Value) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return value.Undefined, value.NewFSError(err)
+	}
+	return value.Ref(value.String(content)), value.Undefined
+}
+
+// Write the content to the file truncating it.
+func writeFile(path, content string, perm os.FileMode) (value.Value, value.Value) {
+	err := os.WriteFile(path, []byte(content), perm)
+	if err != nil {
+		return value.Undefined, value.NewFSError(err)
+	}
+	return value.Nil, value.Undefined
+}
+
+// Returns the path given as a `String` or `Std::FS::Path`.
+func pathArgument(arg value.Value) string {
+	switch path := arg.MustReference().(type) {
+	case value.String:
+		return string(path)
+	case *value.Path:
+		return path.Value
+	default:
+		panic(fmt.Sprintf("invalid path: %s", arg.Inspect()))
+	}
+}
+
+// Returns the Unix permission bits given as an optional argument.
+func permissionsArgument(arg value.Value, defaultPerm os.FileMode) (os.FileMode, value.Value) {
+	if arg.IsUndefined() || arg.IsNil() {
+		return defaultPerm, value.Undefined
+	}
+
+	perm, ok := value.ToGoInt(arg)
+	if !ok || perm < 0 || perm > int(os.ModePerm) {
+		return 0, value.Ref(value.NewError(
+			value.OutOfRangeErrorClass,
+			fmt.Sprintf("invalid permissions: %s", arg.Inspect()),
+		))
+	}
+	return os.FileMode(perm), value.Undefined
+}
+
+// Returns the symlink policy and ignore patterns
+// given as optional arguments.
+func walkOptions(vm *Thread, ignoreArg, symlinksArg value.Value) (value.SymlinkPolicy, []string, value.Value) {
+	symlinks := value.SymlinkNoFollow
+	if !symlinksArg.IsUndefined() && !symlinksArg.IsNil() {
+		var ok bool
+		symlinks, ok = value.SymlinkPolicyFromName(symlinksArg.AsInlineSymbol().String())
+		if !ok {
+			return 0, nil, value.Ref(value.NewError(
+				value.OutOfRangeErrorClass,
+				fmt.Sprintf("invalid symlink policy: %s", symlinksArg.Inspect()),
+			))
+		}
+	}
+
+	if ignoreArg.IsUndefined() || ignoreArg.IsNil() {
+		return symlinks, nil, value.Undefined
+	}
+	var ignore []string
+	for pattern, err := range Iterate(vm, ignoreArg) {
+		if !err.IsUndefined() {
+			return 0, nil, err
+		}
+		ignore = append(ignore, string(pattern.AsString()))
+	}
+	return symlinks, ignore, value.Undefined
+}
+
+// Copy the content and permissions of a regular file.
+func copyFile(from, to string) error {
+	source, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	info, err := source.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return &os.PathError{Op: "copy", Path: from, Err: errIsDirectory}
+	}
+
+	target, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(target, source)
+	closeErr := target.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
@@ -0,0 +1,138 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// Std::FS::Walker
+func initFSWalker() {
+	// Instance methods
+	c := &value.FSWalkerClass.MethodContainer
+	Def(
+		c,
+		"root",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSWalker)(args[0].Pointer())
+			return value.Ref(value.NewPath(self.Root)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"next",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSWalker)(args[0].Pointer())
+			return self.NextValue()
+		},
+	)
+	Def(
+		c,
+		"iter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return args[0], value.Undefined
+		},
+	)
+	Def(
+		c,
+		"skip_dir",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSWalker)(args[0].Pointer())
+			self.SkipDir()
+			return value.Nil, value.Undefined
+		},
+	)
+}
+
+// Std::FS::GlobIterator
+func initFSGlobIterator() {
+	// Instance methods
+	c := &value.FSGlobIteratorClass.MethodContainer
+	Def(
+		c,
+		"pattern",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSGlobIterator)(args[0].Pointer())
+			return value.Ref(value.String(self.Pattern)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"next",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSGlobIterator)(args[0].Pointer())
+			return self.NextValue()
+		},
+	)
+	Def(
+		c,
+		"iter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return args[0], value.Undefined
+		},
+	)
+}
+
+// Std::FS::DirEntry
+func initFSDirEntry() {
+	// Instance methods
+	c := &value.FSDirEntryClass.MethodContainer
+	Def(
+		c,
+		"path",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSDirEntry)(args[0].Pointer())
+			return value.Ref(value.NewPath(self.Path)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"name",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSDirEntry)(args[0].Pointer())
+			return value.Ref(value.String(self.Name())), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"depth",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSDirEntry)(args[0].Pointer())
+			return value.SmallInt(self.Depth).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_dir",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSDirEntry)(args[0].Pointer())
+			return value.BoolVal(self.IsDir()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_file",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSDirEntry)(args[0].Pointer())
+			return value.BoolVal(self.IsFile()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_symlink",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSDirEntry)(args[0].Pointer())
+			return value.BoolVal(self.IsSymlink()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"info",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.FSDirEntry)(args[0].Pointer())
+			info, err := self.Info()
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(info), value.Undefined
+		},
+	)
+}
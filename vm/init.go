@@ -28,6 +28,7 @@ func InitGlobalEnvironment() {
 	initImmutableCollection()
 	initCollection()
 	initTuple()
+	initRange()
 	initClosedRange()
 	initClosedRangeIterator()
 	initComparable()
@@ -68,6 +69,8 @@ func InitGlobalEnvironment() {
 	initOpenRangeIterator()
 	initPair()
 	initRegex()
+	initRegexMatch()
+	initRegexMatchIterator()
 	initRightOpenRange()
 	initRightOpenRangeIterator()
 	initString()
@@ -80,6 +83,7 @@ func InitGlobalEnvironment() {
 	initThread()
 	initThreadPool()
 	initAborter()
+	initAborterSignals()
 	initChannel()
 	initReadChannel()
 	initWriteChannel()
@@ -88,15 +92,41 @@ func InitGlobalEnvironment() {
 	initRWMutex()
 	initROMutex()
 	initOnce()
+	initSemaphore()
+	initCond()
+	initBarrier()
+	initCountDownLatch()
+	initAtomicInt()
+	initAtomicUInt64()
+	initAtomicBool()
+	initAtomicRef()
+	initSyncMap()
+	initSyncQueue()
 	initStackTrace()
 	initStackTraceIterator()
 	initCallFrame()
 	initPromise()
+	initTaskGroup()
+	initActor()
 	initPosition()
 	initSpan()
 	initToken()
 	initLocation()
 	initPath()
+	initFS()
+	initFile()
+	initFileInfo()
+	initFSWalker()
+	initFSGlobIterator()
+	initFSDirEntry()
+	initIO()
+	initOS()
+	initOSSignals()
+	initEnvironment()
+	initProcess()
+	initProcessResult()
+	initPipeReader()
+	initPipeWriter()
 	initWeak()
 	initImmutableBox()
 	initBox()
@@ -106,6 +136,8 @@ func InitGlobalEnvironment() {
 	initTime()
 	initTimeSpan()
 	initDateTime()
+	initTimer()
+	initTicker()
 	initDateTimeSpan()
 	initTimezone()
 }
@@ -0,0 +1,354 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
+)
+
+// A lazily created buffered reader of a standard input.
+// Threads with the same standard input share it
+// so that no buffered data gets lost.
+type stdinBuffer struct {
+	once   sync.Once
+	reader *BufferedReader
+}
+
+// The buffered reader of the standard input of the process.
+var osStdinBuffer stdinBuffer
+
+// Returns a buffered reader of the standard input of the thread.
+func (vm *Thread) stdinReader() *BufferedReader {
+	buffer := vm.stdinBuffer
+	if buffer == nil {
+		if vm.Stdin != os.Stdin {
+			return NewBufferedReader(&ioReader{native: vm.stdinOrEmpty()}, value.Nil, 0)
+		}
+		buffer = &osStdinBuffer
+	}
+
+	buffer.once.Do(func() {
+		buffer.reader = NewBufferedReader(&ioReader{native: vm.stdinOrEmpty()}, value.Nil, 0)
+	})
+	return buffer.reader
+}
+
+// Returns the standard input of the thread
+// or an empty reader when it is nil.
+func (vm *Thread) stdinOrEmpty() io.Reader {
+	if vm.Stdin == nil {
+		return strings.NewReader("")
+	}
+	return vm.Stdin
+}
+
+// Write a string to the standard output or standard error of the thread.
+func (vm *Thread) writeStandard(w *value.StandardWriter, content string) (int, error) {
+	target := vm.Stdout
+	if w.Stderr {
+		target = vm.Stderr
+	}
+	return io.WriteString(target, content)
+}
+
+// An error thrown by an Elk reader or writer.
+type elkIOError struct {
+	err value.Value
+}
+
+func (e *elkIOError) Error() string {
+	return e.err.Inspect()
+}
+
+// Convert an error returned by a reader or writer to an Elk error.
+func ioErrorValue(err error) value.Value {
+	var elkErr *elkIOError
+	if errors.As(err, &elkErr) {
+		return elkErr.err
+	}
+
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) || errors.Is(err, fs.ErrClosed) {
+		return value.NewFSError(err)
+	}
+	return value.Ref(value.NewError(value.IOErrorClass, err.Error()))
+}
+
+// Adapts an `Std::IO::Reader` to `io.Reader`.
+// Native readers are used directly, other values are read
+// by calling their `read` method on the current thread.
+type ioReader struct {
+	thread *Thread
+	reader value.Value
+	native io.Reader
+	rest   string // the part of the last read string that did not fit in the buffer
+}
+
+func newIOReader(thread *Thread, reader value.Value) *ioReader {
+	r := &ioReader{
+		thread: thread,
+		reader: reader,
+	}
+	switch native := reader.SafeAsReference().(type) {
+	case *value.File:
+		r.native = native
+	case *value.StringReader:
+		r.native = native
+	case *value.PipeReader:
+		r.native = native
+	}
+	return r
+}
+
+func (r *ioReader) Read(p []byte) (int, error) {
+	if r.native != nil {
+		return r.native.Read(p)
+	}
+	if len(r.rest) > 0 {
+		n := copy(p, r.rest)
+		r.rest = r.rest[n:]
+		return n, nil
+	}
+
+	result, err := r.thread.CallMethodByName(symbol.L_read, r.reader, value.SmallInt(len(p)).ToValue())
+	if !err.IsUndefined() {
+		return 0, &elkIOError{err: err}
+	}
+	content := string(result.AsString())
+	if len(content) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, content)
+	r.rest = content[n:]
+	return n, nil
+}
+
+// Adapts an `Std::IO::Writer` to `io.Writer`.
+// Native writers are used directly, other values are written to
+// by calling their `write` method on the current thread.
+type ioWriter struct {
+	thread *Thread
+	writer value.Value
+	native io.Writer
+}
+
+func newIOWriter(thread *Thread, writer value.Value) *ioWriter {
+	w := &ioWriter{
+		thread: thread,
+		writer: writer,
+	}
+	switch native := writer.SafeAsReference().(type) {
+	case *value.File:
+		w.native = native
+	case *value.StringWriter:
+		w.native = native
+	case *value.PipeWriter:
+		w.native = native
+	}
+	return w
+}
+
+func (w *ioWriter) Write(p []byte) (int, error) {
+	if w.native != nil {
+		return w.native.Write(p)
+	}
+	if standard, ok := w.writer.SafeAsReference().(*value.StandardWriter); ok {
+		return w.thread.writeStandard(standard, string(p))
+	}
+
+	result, err := w.thread.CallMethodByName(symbol.L_write, w.writer, value.Ref(value.String(p)))
+	if !err.IsUndefined() {
+		return 0, &elkIOError{err: err}
+	}
+	n, ok := value.ToGoInt(result)
+	if !ok || n < 0 || n > len(p) {
+		return 0, fmt.Errorf("invalid number of written bytes: %s", result.Inspect())
+	}
+	if n < len(p) {
+		return n, io.ErrShortWrite
+	}
+	return n, nil
+}
+
+// Returns the read limit given as an optional argument.
+// The limit is negative when the argument is missing.
+func readLimitArgument(arg value.Value) (int, value.Value) {
+	if arg.IsUndefined() || arg.IsNil() {
+		return -1, value.Undefined
+	}
+
+	limit, ok := value.ToGoInt(arg)
+	if !ok || limit < 0 {
+		return 0, value.Ref(value.NewError(
+			value.OutOfRangeErrorClass,
+			fmt.Sprintf("invalid read limit: %s", arg.Inspect()),
+		))
+	}
+	return limit, value.Undefined
+}
+
+// Returns the buffer size given as an optional argument.
+func bufferSizeArgument(arg value.Value) (int, value.Value) {
+	if arg.IsUndefined() || arg.IsNil() {
+		return 0, value.Undefined
+	}
+
+	size, ok := value.ToGoInt(arg)
+	if !ok || size <= 0 {
+		return 0, value.Ref(value.NewError(
+			value.OutOfRangeErrorClass,
+			fmt.Sprintf("invalid buffer size: %s", arg.Inspect()),
+		))
+	}
+	return size, value.Undefined
+}
+
+// Std::IO
+func initIO() {
+	c := &value.IOModule.SingletonClass().MethodContainer
+	Def(
+		c,
+		"stdin",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(vm.stdinReader()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stdout",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.IOStdout), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stderr",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.IOStderr), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"copy_stream",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			reader := newIOReader(vm, args[1])
+			writer := newIOWriter(vm, args[2])
+			n, err := io.Copy(writer, reader)
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.ToElkInt(n), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+
+	initStandardWriter()
+	initStringReader()
+	initStringWriter()
+	initBufferedReader()
+	initBufferedWriter()
+}
+
+// Std::IO::StandardWriter
+func initStandardWriter() {
+	// Instance methods
+	c := &value.IOStandardWriterClass.MethodContainer
+	Def(
+		c,
+		"write",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.StandardWriter)(args[0].Pointer())
+			n, err := vm.writeStandard(self, string(args[1].AsString()))
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.SmallInt(n).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
+
+// Std::IO::StringReader
+func initStringReader() {
+	// Instance methods
+	c := &value.IOStringReaderClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.NewStringReader(string(args[1].AsString()))), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"read",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.StringReader)(args[0].Pointer())
+			limit, errVal := readLimitArgument(args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			content, err := value.ReadString(self, limit)
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.Ref(value.String(content)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"length",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.StringReader)(args[0].Pointer())
+			return value.SmallInt(self.Length()).ToValue(), value.Undefined
+		},
+	)
+}
+
+// Std::IO::StringWriter
+func initStringWriter() {
+	// Instance methods
+	c := &value.IOStringWriterClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.NewStringWriter()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"write",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.StringWriter)(args[0].Pointer())
+			n, _ := self.Write([]byte(args[1].AsString()))
+			return value.SmallInt(n).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"length",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.StringWriter)(args[0].Pointer())
+			return value.SmallInt(self.Length()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"to_string",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.StringWriter)(args[0].Pointer())
+			return value.Ref(value.String(self.String())), value.Undefined
+		},
+	)
+}
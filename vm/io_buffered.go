@@ -0,0 +1,317 @@
+package vm
+
+import (
+	"bufio"
+	"fmt"
+	"sync"
+
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
+)
+
+// Wraps an `Std::IO::Reader` with a buffer.
+// It is safe to use by multiple threads.
+type BufferedReader struct {
+	m      sync.Mutex
+	Source value.Value
+	source *ioReader
+	reader *bufio.Reader
+}
+
+// Create a new buffered reader.
+// The default size of the buffer is used when `size` is not positive.
+func NewBufferedReader(source *ioReader, sourceVal value.Value, size int) *BufferedReader {
+	var reader *bufio.Reader
+	if size > 0 {
+		reader = bufio.NewReaderSize(source, size)
+	} else {
+		reader = bufio.NewReader(source)
+	}
+
+	return &BufferedReader{
+		Source: sourceVal,
+		source: source,
+		reader: reader,
+	}
+}
+
+func (*BufferedReader) Class() *value.Class {
+	return value.IOBufferedReaderClass
+}
+
+func (*BufferedReader) DirectClass() *value.Class {
+	return value.IOBufferedReaderClass
+}
+
+func (*BufferedReader) SingletonClass() *value.Class {
+	return nil
+}
+
+func (r *BufferedReader) Copy() value.Reference {
+	return r
+}
+
+func (r *BufferedReader) ToValue() value.Value {
+	return value.Ref(r)
+}
+
+func (r *BufferedReader) Inspect() string {
+	return fmt.Sprintf("Std::IO::BufferedReader{&: %p, source: %s}", r, r.Source.Inspect())
+}
+
+func (r *BufferedReader) Error() string {
+	return r.Inspect()
+}
+
+func (*BufferedReader) InstanceVariables() *value.InstanceVariables {
+	return nil
+}
+
+// Read up to `limit` bytes, a negative limit reads everything.
+func (r *BufferedReader) ReadString(thread *Thread, limit int) (string, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.source.thread = thread
+	return value.ReadString(r.reader, limit)
+}
+
+// Read the next line without the trailing line break.
+// Returns false when there are no more lines to read.
+func (r *BufferedReader) ReadLine(thread *Thread) (string, bool, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.source.thread = thread
+	return value.ReadLine(r.reader)
+}
+
+// Wraps an `Std::IO::Writer` with a buffer.
+// It is safe to use by multiple threads.
+type BufferedWriter struct {
+	m      sync.Mutex
+	Target value.Value
+	target *ioWriter
+	writer *bufio.Writer
+}
+
+// Create a new buffered writer.
+// The default size of the buffer is used when `size` is not positive.
+func NewBufferedWriter(target *ioWriter, targetVal value.Value, size int) *BufferedWriter {
+	var writer *bufio.Writer
+	if size > 0 {
+		writer = bufio.NewWriterSize(target, size)
+	} else {
+		writer = bufio.NewWriter(target)
+	}
+
+	return &BufferedWriter{
+		Target: targetVal,
+		target: target,
+		writer: writer,
+	}
+}
+
+func (*BufferedWriter) Class() *value.Class {
+	return value.IOBufferedWriterClass
+}
+
+func (*BufferedWriter) DirectClass() *value.Class {
+	return value.IOBufferedWriterClass
+}
+
+func (*BufferedWriter) SingletonClass() *value.Class {
+	return nil
+}
+
+func (w *BufferedWriter) Copy() value.Reference {
+	return w
+}
+
+func (w *BufferedWriter) ToValue() value.Value {
+	return value.Ref(w)
+}
+
+func (w *BufferedWriter) Inspect() string {
+	return fmt.Sprintf("Std::IO::BufferedWriter{&: %p, target: %s}", w, w.Target.Inspect())
+}
+
+func (w *BufferedWriter) Error() string {
+	return w.Inspect()
+}
+
+func (*BufferedWriter) InstanceVariables() *value.InstanceVariables {
+	return nil
+}
+
+// Write a string to the buffer.
+func (w *BufferedWriter) WriteString(thread *Thread, content string) (int, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.target.thread = thread
+	return w.writer.WriteString(content)
+}
+
+// Write the buffered data to the target.
+func (w *BufferedWriter) Flush(thread *Thread) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.target.thread = thread
+	return w.writer.Flush()
+}
+
+// Std::IO::BufferedReader
+func initBufferedReader() {
+	// Instance methods
+	c := &value.IOBufferedReaderClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			size, errVal := bufferSizeArgument(args[2])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			reader := NewBufferedReader(newIOReader(vm, args[1]), args[1], size)
+			return value.Ref(reader), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"source",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedReader)(args[0].Pointer())
+			return self.Source, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"read",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedReader)(args[0].Pointer())
+			limit, errVal := readLimitArgument(args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			content, err := self.ReadString(vm, limit)
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.Ref(value.String(content)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"read_line",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedReader)(args[0].Pointer())
+			line, ok, err := self.ReadLine(vm)
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			if !ok {
+				return value.Nil, value.Undefined
+			}
+			return value.Ref(value.String(line)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"each_line",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedReader)(args[0].Pointer())
+			fn := args[1]
+			for {
+				line, ok, err := self.ReadLine(vm)
+				if err != nil {
+					return value.Undefined, ioErrorValue(err)
+				}
+				if !ok {
+					return value.Nil, value.Undefined
+				}
+				_, errVal := vm.CallCallable(fn, value.Ref(value.String(line)))
+				if !errVal.IsUndefined() {
+					return value.Undefined, errVal
+				}
+			}
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"iter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return args[0], value.Undefined
+		},
+	)
+	Def(
+		c,
+		"next",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedReader)(args[0].Pointer())
+			line, ok, err := self.ReadLine(vm)
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			if !ok {
+				return value.Undefined, symbol.L_stop_iteration.ToValue()
+			}
+			return value.Ref(value.String(line)), value.Undefined
+		},
+	)
+}
+
+// Std::IO::BufferedWriter
+func initBufferedWriter() {
+	// Instance methods
+	c := &value.IOBufferedWriterClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			size, errVal := bufferSizeArgument(args[2])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			writer := NewBufferedWriter(newIOWriter(vm, args[1]), args[1], size)
+			return value.Ref(writer), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"target",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedWriter)(args[0].Pointer())
+			return self.Target, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"write",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedWriter)(args[0].Pointer())
+			n, err := self.WriteString(vm, string(args[1].AsString()))
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.SmallInt(n).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"flush",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*BufferedWriter)(args[0].Pointer())
+			if err := self.Flush(vm); err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.Nil, value.Undefined
+		},
+	)
+}
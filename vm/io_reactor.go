@@ -0,0 +1,98 @@
+package vm
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Default maximum number of blocking operations
+// executed at the same time by the I/O reactor.
+const DEFAULT_IO_REACTOR_CONCURRENCY = 512
+
+// The I/O reactor used by native methods.
+var DefaultIOReactor = NewIOReactor(DEFAULT_IO_REACTOR_CONCURRENCY)
+
+// A blocking native operation like a file system call.
+// The context gets cancelled when the operation is aborted.
+type IOOperation func(ctx context.Context) (result value.Value, err value.Value)
+
+// Executes blocking native operations outside of thread pools.
+//
+// Every operation runs in a dedicated goroutine
+// and returns an external promise settled by that goroutine.
+// Async methods awaiting the promise park their continuation
+// instead of blocking a thread worker.
+type IOReactor struct {
+	slots   chan struct{} // limits the number of operations executed at the same time
+	queued  atomic.Int64  // number of operations waiting for a free slot
+	running atomic.Int64  // number of operations that are currently being executed
+}
+
+// Create a new I/O reactor that executes
+// at most `concurrency` operations at the same time.
+func NewIOReactor(concurrency int) *IOReactor {
+	return &IOReactor{
+		slots: make(chan struct{}, concurrency),
+	}
+}
+
+// Returns the maximum number of operations
+// executed at the same time.
+func (r *IOReactor) Concurrency() int {
+	return cap(r.slots)
+}
+
+// Returns the number of operations waiting for a free slot.
+func (r *IOReactor) QueuedCount() int {
+	return int(r.queued.Load())
+}
+
+// Returns the number of operations that are currently being executed.
+func (r *IOReactor) RunningCount() int {
+	return int(r.running.Load())
+}
+
+// Execute the operation in a new goroutine.
+// Returns a promise that gets settled with the result of the operation,
+// its continuations get executed by the given thread pool.
+//
+// The operation gets aborted when the given aborter gets closed.
+// Cancelling the promise rejects it immediately,
+// the result of the operation is then discarded.
+func (r *IOReactor) Submit(threadPool *ThreadPool, aborter *value.Aborter, op IOOperation) *Promise {
+	if aborter == nil {
+		aborter = value.GLOBAL_ABORTER
+	}
+
+	p := NewExternalPromise(threadPool)
+	r.queued.Add(1)
+	go r.execute(p, aborter.Context(), op)
+
+	return p
+}
+
+func (r *IOReactor) execute(p *Promise, ctx context.Context, op IOOperation) {
+	select {
+	case r.slots <- struct{}{}:
+		r.queued.Add(-1)
+	case <-ctx.Done():
+		r.queued.Add(-1)
+		p.Reject(value.ExecutionAbortedError.ToValue(), nil)
+		return
+	}
+
+	r.running.Add(1)
+	result, err := op(ctx)
+	r.running.Add(-1)
+	<-r.slots
+
+	p.ResolveReject(result, err)
+}
+
+// Execute a blocking operation with the I/O reactor.
+// The returned promise is aborted together with the thread.
+func (vm *Thread) SubmitIO(op IOOperation) *Promise {
+	return DefaultIOReactor.Submit(vm.threadPool, vm.Aborter, op)
+}
@@ -0,0 +1,125 @@
+package vm_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
+)
+
+func TestIOReactorSubmit(t *testing.T) {
+	tp := vm.NewThreadPool(1, 10)
+	defer tp.Close()
+	reactor := vm.NewIOReactor(4)
+
+	release := make(chan struct{})
+	p := reactor.Submit(tp, nil, func(ctx context.Context) (value.Value, value.Value) {
+		<-release
+		return value.SmallInt(5).ToValue(), value.Undefined
+	})
+
+	// the only worker of the pool is not blocked by the operation
+	other := tp.Call(func(_ *vm.Thread) (value.Value, value.Value) {
+		return value.Nil, value.Undefined
+	})
+	if _, _, err := other.AwaitSync(); !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+	if p.IsResolved() {
+		t.Fatal("the operation should still be running")
+	}
+
+	close(release)
+	result, _, err := p.AwaitSync()
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+	if result != value.SmallInt(5).ToValue() {
+		t.Fatalf("invalid result: %s", result.Inspect())
+	}
+}
+
+func TestIOReactorReject(t *testing.T) {
+	reactor := vm.NewIOReactor(4)
+	wantErr := value.Ref(value.NewError(value.ErrorClass, "foo"))
+
+	p := reactor.Submit(vm.DefaultThreadPool, nil, func(ctx context.Context) (value.Value, value.Value) {
+		return value.Undefined, wantErr
+	})
+	_, _, err := p.AwaitSync()
+	if err != wantErr {
+		t.Fatalf("invalid error: %s", err.Inspect())
+	}
+}
+
+func TestIOReactorAbort(t *testing.T) {
+	reactor := vm.NewIOReactor(4)
+	aborter := value.NewCancelAborter(value.GLOBAL_ABORTER)
+
+	p := reactor.Submit(vm.DefaultThreadPool, aborter, func(ctx context.Context) (value.Value, value.Value) {
+		<-ctx.Done()
+		return value.Undefined, value.ExecutionAbortedError.ToValue()
+	})
+	aborter.Close()
+
+	_, _, err := p.AwaitSync()
+	if err != value.ExecutionAbortedError.ToValue() {
+		t.Fatalf("invalid error: %s", err.Inspect())
+	}
+}
+
+func TestIOReactorCancel(t *testing.T) {
+	reactor := vm.NewIOReactor(4)
+
+	release := make(chan struct{})
+	defer close(release)
+	p := reactor.Submit(vm.DefaultThreadPool, nil, func(ctx context.Context) (value.Value, value.Value) {
+		<-release
+		return value.Nil, value.Undefined
+	})
+	p.Cancel()
+
+	_, _, err := p.AwaitSync()
+	if err != value.ExecutionAbortedError.ToValue() {
+		t.Fatalf("invalid error: %s", err.Inspect())
+	}
+}
+
+func TestIOReactorConcurrency(t *testing.T) {
+	reactor := vm.NewIOReactor(1)
+
+	release := make(chan struct{})
+	first := reactor.Submit(vm.DefaultThreadPool, nil, func(ctx context.Context) (value.Value, value.Value) {
+		<-release
+		return value.Nil, value.Undefined
+	})
+	waitForIOReactor(reactor, 1, 0, t)
+	second := reactor.Submit(vm.DefaultThreadPool, nil, func(ctx context.Context) (value.Value, value.Value) {
+		return value.Nil, value.Undefined
+	})
+	waitForIOReactor(reactor, 1, 1, t)
+	if second.IsResolved() {
+		t.Fatal("the second operation should wait for a free slot")
+	}
+
+	close(release)
+	first.AwaitSync()
+	second.AwaitSync()
+	if reactor.RunningCount() != 0 || reactor.QueuedCount() != 0 {
+		t.Fatalf("running: %d, queued: %d", reactor.RunningCount(), reactor.QueuedCount())
+	}
+}
+
+func waitForIOReactor(reactor *vm.IOReactor, running, queued int, t *testing.T) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for reactor.RunningCount() != running || reactor.QueuedCount() != queued {
+		if time.Now().After(deadline) {
+			t.Fatalf("running: %d, queued: %d", reactor.RunningCount(), reactor.QueuedCount())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
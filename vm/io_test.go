@@ -0,0 +1,93 @@
+package vm_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elk-language/elk"
+	"github.com/elk-language/elk/types/checker"
+	"github.com/elk-language/elk/vm"
+)
+
+func TestIOStdin(t *testing.T) {
+	elk.InitGlobalEnvironment()
+	typechecker := checker.New()
+	chunk, compileErr := typechecker.CheckSourceBytecode(testFileName, `
+		println IO.stdin.read_line
+		IO.stdin.each_line |line| -> IO.stderr.write("got: ${line}\n")
+		println(IO.stdin.read_line ?? "eof")
+	`)
+	if compileErr.IsFailure() {
+		t.Fatalf("Compile Error: %s", compileErr.Error())
+	}
+
+	var stdout, stderr strings.Builder
+	v := vm.New(
+		vm.WithStdin(strings.NewReader("foo\nbar\r\nbaz")),
+		vm.WithStdout(&stdout),
+		vm.WithStderr(&stderr),
+	)
+	_, err := v.InterpretTopLevel(chunk)
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+
+	if want := "foo\neof\n"; stdout.String() != want {
+		t.Fatalf("invalid stdout, want: %q, got: %q", want, stdout.String())
+	}
+	if want := "got: bar\ngot: baz\n"; stderr.String() != want {
+		t.Fatalf("invalid stderr, want: %q, got: %q", want, stderr.String())
+	}
+}
+
+func TestIOStdinSharedWithThreads(t *testing.T) {
+	elk.InitGlobalEnvironment()
+	typechecker := checker.New()
+	chunk, compileErr := typechecker.CheckSourceBytecode(testFileName, `
+		println IO.stdin.read_line
+		t := go println(IO.stdin.read_line)
+		t.join
+	`)
+	if compileErr.IsFailure() {
+		t.Fatalf("Compile Error: %s", compileErr.Error())
+	}
+
+	stdout := newConcurrentStringBuilder()
+	v := vm.New(
+		vm.WithStdin(strings.NewReader("foo\nbar\n")),
+		vm.WithStdout(stdout),
+	)
+	_, err := v.InterpretTopLevel(chunk)
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+
+	if want := "foo\nbar\n"; stdout.String() != want {
+		t.Fatalf("invalid stdout, want: %q, got: %q", want, stdout.String())
+	}
+}
+
+func TestIOStdinNil(t *testing.T) {
+	elk.InitGlobalEnvironment()
+	typechecker := checker.New()
+	chunk, compileErr := typechecker.CheckSourceBytecode(testFileName, `
+		println(IO.stdin.read_line ?? "eof")
+	`)
+	if compileErr.IsFailure() {
+		t.Fatalf("Compile Error: %s", compileErr.Error())
+	}
+
+	var stdout strings.Builder
+	v := vm.New(
+		vm.WithStdin(nil),
+		vm.WithStdout(&stdout),
+	)
+	_, err := v.InterpretTopLevel(chunk)
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+
+	if want := "eof\n"; stdout.String() != want {
+		t.Fatalf("invalid stdout, want: %q, got: %q", want, stdout.String())
+	}
+}
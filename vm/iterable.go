@@ -1,6 +1,8 @@
 package vm
 
 import (
+	"strings"
+
 	"github.com/elk-language/elk/value"
 )
 
@@ -659,6 +661,40 @@ func initIterableFiniteBase() {
 		DefWithParameters(2),
 	)
 
+	Def(
+		c,
+		"join",
+		func(vm *Thread, args []value.Value) (returnVal value.Value, err value.Value) {
+			self := args[0]
+			var separator string
+			if !args[1].IsUndefined() {
+				separator = args[1].AsString().String()
+			}
+
+			var buff strings.Builder
+			var i int
+			for elem, err := range Iterate(vm, self) {
+				if !err.IsUndefined() {
+					return value.Undefined, err
+				}
+
+				str, err := ToString(vm, elem)
+				if !err.IsUndefined() {
+					return value.Undefined, err
+				}
+
+				if i > 0 {
+					buff.WriteString(separator)
+				}
+				buff.WriteString(str.AsString().String())
+				i++
+			}
+
+			return value.Ref(value.String(buff.String())), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+
 	Def(
 		c,
 		"to_list",
@@ -699,6 +735,7 @@ func initIterableFiniteBase() {
 	)
 	Alias(c, "to_immutable_collection", "to_tuple")
 
+	defParallelIterableMethods(c)
 }
 
 // Std::Iterable::Base
@@ -0,0 +1,323 @@
+package vm
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/elk-language/elk/bytecode"
+	"github.com/elk-language/elk/value"
+)
+
+// Number of chunks created for every thread
+// when the chunk size is not given explicitly.
+const parallelChunksPerThread = 4
+
+// Work split into chunks that get processed
+// in parallel by the workers of a thread pool.
+type parallelJob struct {
+	elements  []value.Value
+	chunkSize int
+	// processes the elements in [start, end)
+	fn func(thread *Thread, start, end int) value.Value
+
+	nextChunk  atomic.Int64
+	chunkCount int
+	// gets marked as done when a chunk is processed or skipped
+	wg sync.WaitGroup
+	// shared by all threads, gets closed on the first error
+	aborter *value.Aborter
+
+	m   sync.Mutex
+	err value.Value
+}
+
+// Claim and process chunks until there are none left.
+func (j *parallelJob) run(thread *Thread) {
+	for {
+		chunk := int(j.nextChunk.Add(1) - 1)
+		if chunk >= j.chunkCount {
+			return
+		}
+
+		if !value.ShouldAbort(j.aborter) {
+			start := chunk * j.chunkSize
+			end := min(start+j.chunkSize, len(j.elements))
+			if err := j.fn(thread, start, end); !err.IsUndefined() {
+				j.fail(err)
+			}
+		}
+		j.wg.Done()
+	}
+}
+
+// Save the first error and cancel the rest of the job.
+func (j *parallelJob) fail(err value.Value) {
+	j.m.Lock()
+	if j.err.IsUndefined() {
+		j.err = err
+	}
+	j.m.Unlock()
+
+	j.aborter.Close()
+}
+
+// Process the elements of an iterable in parallel on the thread pool
+// of the current thread.
+// The current thread processes chunks too, so the job gets done
+// even when all workers of the pool are busy.
+func parallelIterate(
+	vm *Thread,
+	iterable value.Value,
+	chunkSizeVal value.Value,
+	fn func(thread *Thread, elements []value.Value, start, end int) value.Value,
+) ([]value.Value, int, value.Value) {
+	var elements []value.Value
+	for elem, err := range Iterate(vm, iterable) {
+		if !err.IsUndefined() {
+			return nil, 0, err
+		}
+		elements = append(elements, elem)
+	}
+
+	pool := vm.threadPool
+	var chunkSize int
+	if chunkSizeVal.IsUndefined() || chunkSizeVal.IsNil() {
+		chunks := (pool.ThreadCount() + 1) * parallelChunksPerThread
+		chunkSize = max((len(elements)+chunks-1)/chunks, 1)
+	} else {
+		var ok bool
+		chunkSize, ok = value.ToGoInt(chunkSizeVal)
+		if !ok || chunkSize < 1 {
+			return nil, 0, value.Ref(value.NewError(
+				value.OutOfRangeErrorClass,
+				fmt.Sprintf("invalid chunk size: %s", chunkSizeVal.Inspect()),
+			))
+		}
+	}
+	if len(elements) == 0 {
+		return elements, chunkSize, value.Undefined
+	}
+
+	job := &parallelJob{
+		elements:   elements,
+		chunkSize:  chunkSize,
+		chunkCount: (len(elements) + chunkSize - 1) / chunkSize,
+		aborter:    value.NewCancelAborter(vm.Aborter),
+	}
+	job.fn = func(thread *Thread, start, end int) value.Value {
+		return fn(thread, elements, start, end)
+	}
+	job.wg.Add(job.chunkCount)
+	defer job.aborter.Close()
+
+	helpers := min(pool.ThreadCount(), job.chunkCount-1)
+	for range helpers {
+		NewNativePromiseWithAborter(
+			pool,
+			job.aborter,
+			func(thread *Thread, _ []value.Value) (value.Value, value.Value) {
+				job.run(thread)
+				return value.Nil, value.Undefined
+			},
+		)
+	}
+
+	prevAborter := vm.Aborter
+	vm.Aborter = job.aborter
+	job.run(vm)
+	vm.Aborter = prevAborter
+
+	// chunks claimed by workers may still be processed
+	job.wg.Wait()
+	if !job.err.IsUndefined() {
+		return nil, chunkSize, job.err
+	}
+	if value.ShouldAbort(job.aborter) {
+		// the aborter of the current thread has been closed
+		return nil, chunkSize, value.ExecutionAbortedError.ToValue()
+	}
+	return elements, chunkSize, value.Undefined
+}
+
+// Prepare a callable value to be called by many threads at the same time.
+// Bytecode closures get copied with the current values of their captured variables,
+// so that workers never read the stack of the current thread.
+// Closures that reassign captured variables are rejected
+// because their assignments would race with each other.
+func parallelCallable(fn value.Value) (value.Value, value.Value) {
+	closure, ok := fn.SafeAsReference().(*BytecodeClosure)
+	if !ok || len(closure.Upvalues) == 0 {
+		return fn, value.Undefined
+	}
+
+	if closure.Bytecode.setsUpvalues(make(map[*BytecodeFunction]bool)) {
+		return value.Undefined, value.Ref(value.Errorf(
+			value.ArgumentErrorClass,
+			"closure `%s` called in parallel cannot reassign captured variables",
+			closure.Inspect(),
+		))
+	}
+
+	closureCopy := &BytecodeClosure{
+		VMID:     closure.VMID,
+		Bytecode: closure.Bytecode,
+		Self:     closure.Self,
+		Upvalues: make([]*Upvalue, len(closure.Upvalues)),
+	}
+	for i, upvalue := range closure.Upvalues {
+		closureCopy.Upvalues[i] = NewClosedUpvalue(upvalue.Get())
+	}
+	return value.Ref(closureCopy), value.Undefined
+}
+
+// Whether the function or any function nested in it
+// assigns to an upvalue.
+func (f *BytecodeFunction) setsUpvalues(visited map[*BytecodeFunction]bool) bool {
+	if visited[f] {
+		return false
+	}
+	visited[f] = true
+
+	for offset := 0; offset < len(f.Instructions); {
+		switch bytecode.OpCode(f.Instructions[offset]) {
+		case bytecode.SET_UPVALUE_0, bytecode.SET_UPVALUE_1, bytecode.SET_UPVALUE8, bytecode.SET_UPVALUE16:
+			return true
+		}
+
+		next, err := f.DisassembleInstruction(io.Discard, offset)
+		if err != nil {
+			return true
+		}
+		offset = next
+	}
+
+	for _, val := range f.Values {
+		if nested, ok := val.SafeAsReference().(*BytecodeFunction); ok && nested.setsUpvalues(visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func defParallelIterableMethods(c *value.MethodContainer) {
+	Def(
+		c,
+		"par_map",
+		func(vm *Thread, args []value.Value) (returnVal value.Value, err value.Value) {
+			self := args[0]
+			fn, err := parallelCallable(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			elements, _, err := parallelIterate(
+				vm,
+				self,
+				args[2],
+				func(thread *Thread, elements []value.Value, start, end int) value.Value {
+					for i := start; i < end; i++ {
+						newElem, err := thread.CallCallable(fn, elements[i])
+						if !err.IsUndefined() {
+							return err
+						}
+						// every chunk writes to its own part of the slice
+						elements[i] = newElem
+					}
+					return value.Undefined
+				},
+			)
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			result := value.ArrayListOfValue(elements)
+			return value.Ref(&result), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+
+	Def(
+		c,
+		"par_each",
+		func(vm *Thread, args []value.Value) (returnVal value.Value, err value.Value) {
+			self := args[0]
+			fn, err := parallelCallable(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			_, _, err = parallelIterate(
+				vm,
+				self,
+				args[2],
+				func(thread *Thread, elements []value.Value, start, end int) value.Value {
+					for i := start; i < end; i++ {
+						_, err := thread.CallCallable(fn, elements[i])
+						if !err.IsUndefined() {
+							return err
+						}
+					}
+					return value.Undefined
+				},
+			)
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+
+	Def(
+		c,
+		"par_reduce",
+		func(vm *Thread, args []value.Value) (returnVal value.Value, err value.Value) {
+			self := args[0]
+			fn, err := parallelCallable(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			// the partial result of every chunk is saved
+			// in place of the chunk's first element
+			elements, chunkSize, err := parallelIterate(
+				vm,
+				self,
+				args[2],
+				func(thread *Thread, elements []value.Value, start, end int) value.Value {
+					accumulator := elements[start]
+					for i := start + 1; i < end; i++ {
+						newValue, err := thread.CallCallable(fn, accumulator, elements[i])
+						if !err.IsUndefined() {
+							return err
+						}
+						accumulator = newValue
+					}
+					elements[start] = accumulator
+					return value.Undefined
+				},
+			)
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			if len(elements) == 0 {
+				return value.Nil, value.Undefined
+			}
+
+			accumulator := elements[0]
+			for i := chunkSize; i < len(elements); i += chunkSize {
+				newValue, err := vm.CallCallable(fn, accumulator, elements[i])
+				if !err.IsUndefined() {
+					return value.Undefined, err
+				}
+				accumulator = newValue
+			}
+
+			return accumulator, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+}
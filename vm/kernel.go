@@ -1,7 +1,7 @@
 package vm
 
 import (
-	"fmt"
+	"context"
 	"os"
 	"time"
 
@@ -19,6 +19,7 @@ func initKernel() {
 			if !args[1].IsUndefined() {
 				code = args[1].AsInt()
 			}
+			vm.RunAtExitHooks()
 			os.Exit(code)
 			return value.Nil, value.Undefined
 		},
@@ -38,7 +39,7 @@ func initKernel() {
 					return value.Undefined, err
 				}
 				r := result.MustReference().(value.String).String()
-				fmt.Fprint(vm.Stdout, r)
+				vm.writeStandard(value.IOStdout, r)
 			}
 
 			return value.Nil, value.Undefined
@@ -55,7 +56,7 @@ func initKernel() {
 				return value.Undefined, err
 			}
 			r := result.MustReference().(value.String).String()
-			fmt.Fprint(vm.Stdout, r)
+			vm.writeStandard(value.IOStdout, r)
 
 			return value.Nil, value.Undefined
 		},
@@ -79,11 +80,11 @@ func initKernel() {
 					return value.Undefined, err
 				}
 				r := result.MustReference().(value.String).String()
-				fmt.Fprintln(vm.Stdout, r)
+				vm.writeStandard(value.IOStdout, r+"\n")
 			}
 
 			if !iterated {
-				fmt.Fprintln(vm.Stdout)
+				vm.writeStandard(value.IOStdout, "\n")
 			}
 
 			return value.Nil, value.Undefined
@@ -101,7 +102,7 @@ func initKernel() {
 				return value.Undefined, err
 			}
 			r := result.MustReference().(value.String).String()
-			fmt.Fprintln(vm.Stdout, r)
+			vm.writeStandard(value.IOStdout, r+"\n")
 
 			return value.Nil, value.Undefined
 		},
@@ -122,7 +123,14 @@ func initKernel() {
 				duration = durationVal.AsInlineTimeSpan()
 			}
 
-			time.Sleep(duration.Native())
+			timer := time.NewTimer(duration.Native())
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-vm.Aborter.Context().Done():
+				return value.Undefined, value.ExecutionAbortedError.ToValue()
+			}
 
 			return value.Nil, value.Undefined
 		},
@@ -141,12 +149,26 @@ func initKernel() {
 				duration = durationVal.AsInlineTimeSpan()
 			}
 
-			p := NewExternalPromise(vm.threadPool)
+			aborter := vm.Aborter
+			if aborter == nil {
+				aborter = value.GLOBAL_ABORTER
+			}
 
-			go func(p *Promise, d time.Duration) {
-				<-time.After(d)
+			// timers do not hold I/O reactor slots or goroutines while they wait
+			p := NewExternalPromise(vm.threadPool)
+			var stopAbort func() bool
+			registered := make(chan struct{})
+			timer := time.AfterFunc(duration.Native(), func() {
+				<-registered
+				stopAbort()
 				p.Resolve(value.Nil)
-			}(p, duration.Native())
+			})
+			stopAbort = context.AfterFunc(aborter.Context(), func() {
+				if timer.Stop() {
+					p.Reject(value.ExecutionAbortedError.ToValue(), nil)
+				}
+			})
+			close(registered)
 
 			return value.Ref(p), value.Undefined
 		},
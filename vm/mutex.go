@@ -11,9 +11,13 @@ func initMutex() {
 	Def(
 		c,
 		"lock",
-		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
 			self := (*value.Mutex)(args[0].Pointer())
-			self.Lock()
+			if !self.Native.TryLock() {
+				vm.block("Std::Sync::Mutex#lock")
+				self.Lock()
+				vm.unblock()
+			}
 			return value.Nil, value.Undefined
 		},
 	)
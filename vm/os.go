@@ -0,0 +1,202 @@
+package vm
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Command-line arguments of the Elk program.
+var osArgs []string
+
+// Set the command-line arguments returned by `OS.args`.
+// Should be called before the program starts running.
+func SetArgs(args []string) {
+	osArgs = slices.Clone(args)
+}
+
+// Functions registered with `OS.at_exit`.
+var atExitHooks struct {
+	m     sync.Mutex
+	hooks []value.Value
+}
+
+// Call the functions registered with `OS.at_exit`
+// in the reverse order of registration.
+// Every function gets called at most once.
+// Errors thrown by the functions get printed to stderr.
+func (vm *Thread) RunAtExitHooks() {
+	for {
+		atExitHooks.m.Lock()
+		if len(atExitHooks.hooks) == 0 {
+			atExitHooks.m.Unlock()
+			return
+		}
+		last := len(atExitHooks.hooks) - 1
+		hook := atExitHooks.hooks[last]
+		atExitHooks.hooks = atExitHooks.hooks[:last]
+		atExitHooks.m.Unlock()
+
+		_, err := vm.CallCallable(hook)
+		if !err.IsUndefined() {
+			PrintError(vm.Stderr, vm.ErrStackTrace(), err)
+		}
+	}
+}
+
+// Std::OS
+func initOS() {
+	c := &value.OSModule.SingletonClass().MethodContainer
+	Def(
+		c,
+		"args",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			list := value.NewArrayListOfValue(len(osArgs))
+			for _, arg := range osArgs {
+				list.Append(value.Ref(value.String(arg)))
+			}
+			return value.Ref(list), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"env",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.OSEnv), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"set_env",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			err := os.Setenv(args[1].AsString().String(), args[2].AsString().String())
+			if err != nil {
+				return value.Undefined, value.Ref(value.NewError(value.OSErrorClass, err.Error()))
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"unset_env",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			err := os.Unsetenv(args[1].AsString().String())
+			if err != nil {
+				return value.Undefined, value.Ref(value.NewError(value.OSErrorClass, err.Error()))
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"env_map",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			environ := os.Environ()
+			hmap := NewHashMapOfValue(len(environ))
+			for _, entry := range environ {
+				name, val, _ := strings.Cut(entry, "=")
+				err := HashMapOfValueSet(vm, hmap, value.Ref(value.String(name)), value.Ref(value.String(val)))
+				if !err.IsUndefined() {
+					return value.Undefined, err
+				}
+			}
+			return value.Ref(hmap), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"cwd",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			dir, err := os.Getwd()
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Ref(value.String(dir)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"chdir",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			err := os.Chdir(pathArgument(args[1]))
+			if err != nil {
+				return value.Undefined, value.NewFSError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"hostname",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			name, err := os.Hostname()
+			if err != nil {
+				return value.Undefined, value.Ref(value.NewError(value.OSErrorClass, err.Error()))
+			}
+			return value.Ref(value.String(name)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"pid",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.SmallInt(os.Getpid()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"at_exit",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			atExitHooks.m.Lock()
+			atExitHooks.hooks = append(atExitHooks.hooks, args[1])
+			atExitHooks.m.Unlock()
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
+
+// Std::OS::Environment
+func initEnvironment() {
+	c := &value.OSEnvironmentClass.MethodContainer
+	Def(
+		c,
+		"[]",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			val, ok := os.LookupEnv(args[1].AsString().String())
+			if !ok {
+				return value.Nil, value.Undefined
+			}
+			return value.Ref(value.String(val)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Alias(c, "get", "[]")
+	Def(
+		c,
+		"[]=",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			err := os.Setenv(args[1].AsString().String(), args[2].AsString().String())
+			if err != nil {
+				return value.Undefined, value.Ref(value.NewError(value.OSErrorClass, err.Error()))
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"contains",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			_, ok := os.LookupEnv(args[1].AsString().String())
+			return value.BoolVal(ok), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
@@ -0,0 +1,235 @@
+package vm
+
+import (
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Size of the buffers of channels that receive signals.
+const SIGNAL_BUFFER_SIZE = 8
+
+// Returns the name of a signal as a symbol.
+func signalName(sig os.Signal) value.Symbol {
+	for name, s := range signalsByName {
+		if s == sig {
+			return name
+		}
+	}
+	return value.ToSymbol(sig.String())
+}
+
+// Returns the signals represented by a symbol
+// or an iterable of symbols.
+func signalsArgument(vm *Thread, arg value.Value) ([]os.Signal, value.Value) {
+	if arg.IsInlineSymbol() {
+		sig, errVal := signalArgument(arg)
+		if !errVal.IsUndefined() {
+			return nil, errVal
+		}
+		return []os.Signal{sig}, value.Undefined
+	}
+
+	var signals []os.Signal
+	for element, err := range Iterate(vm, arg) {
+		if !err.IsUndefined() {
+			return nil, err
+		}
+		sig, errVal := signalArgument(element)
+		if !errVal.IsUndefined() {
+			return nil, errVal
+		}
+		signals = append(signals, sig)
+	}
+	return signals, value.Undefined
+}
+
+// Channels registered with `notifySignals`
+// and the signals they receive.
+var signalSubscriptions = struct {
+	m        sync.Mutex
+	channels map[chan os.Signal][]os.Signal
+}{
+	channels: make(map[chan os.Signal][]os.Signal),
+}
+
+// Create a channel that receives the given signals.
+// It should be passed to `stopSignals` when it is no longer needed.
+func notifySignals(signals []os.Signal) chan os.Signal {
+	ch := make(chan os.Signal, SIGNAL_BUFFER_SIZE)
+
+	signalSubscriptions.m.Lock()
+	signalSubscriptions.channels[ch] = signals
+	signalSubscriptions.m.Unlock()
+
+	signal.Notify(ch, signals...)
+	return ch
+}
+
+// Stop delivering signals to a channel created by `notifySignals`.
+func stopSignals(ch chan os.Signal) {
+	signal.Stop(ch)
+
+	signalSubscriptions.m.Lock()
+	delete(signalSubscriptions.channels, ch)
+	signalSubscriptions.m.Unlock()
+}
+
+// Deliver a signal to all channels that have been registered
+// to receive it without sending it to the process.
+// Reports whether any channel has been registered for it.
+//
+// Used in tests.
+func SimulateSignal(sig os.Signal) bool {
+	signalSubscriptions.m.Lock()
+	defer signalSubscriptions.m.Unlock()
+
+	var delivered bool
+	for ch, signals := range signalSubscriptions.channels {
+		if !slices.Contains(signals, sig) {
+			continue
+		}
+
+		delivered = true
+		// like the runtime, drop the signal when the channel is full
+		select {
+		case ch <- sig:
+		default:
+		}
+	}
+	return delivered
+}
+
+// An Elk channel that receives signals sent to the process.
+// Closing it stops the delivery of signals
+// and restores their default behaviour.
+type signalChannel struct {
+	*value.NativeTransformerChannel[os.Signal]
+	ch chan os.Signal
+}
+
+func newSignalChannel(signals []os.Signal) *signalChannel {
+	ch := notifySignals(signals)
+	return &signalChannel{
+		NativeTransformerChannel: value.NewNativeTransformerChannel(
+			ch,
+			func(sig os.Signal) value.Value { return signalName(sig).ToValue() },
+			func(v value.Value) os.Signal { return pushedSignal(v.AsInlineSymbol()) },
+		),
+		ch: ch,
+	}
+}
+
+func (ch *signalChannel) Close() value.Value {
+	stopSignals(ch.ch)
+	return ch.NativeTransformerChannel.Close()
+}
+
+func (ch *signalChannel) Copy() value.Reference {
+	return ch
+}
+
+func (ch *signalChannel) ToValue() value.Value {
+	return value.Ref(ch)
+}
+
+// A signal pushed to a signal channel by Elk code.
+type pushedSignal value.Symbol
+
+func (s pushedSignal) String() string {
+	return value.Symbol(s).String()
+}
+
+func (pushedSignal) Signal() {}
+
+// Std::OS signal functions
+func initOSSignals() {
+	c := &value.OSModule.SingletonClass().MethodContainer
+	Def(
+		c,
+		"signals",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			signals, errVal := signalsArgument(vm, args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+
+			return value.Ref(newSignalChannel(signals)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"on_signal",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			signals, errVal := signalsArgument(vm, args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			handler := args[2]
+
+			ch := notifySignals(signals)
+			closure := NewNativeClosure(
+				func(thread *Thread, _ []value.Value) (value.Value, value.Value) {
+					defer stopSignals(ch)
+
+					done := thread.Aborter.Context().Done()
+					for {
+						select {
+						case sig := <-ch:
+							_, err := thread.CallCallable(handler, signalName(sig).ToValue())
+							if !err.IsUndefined() {
+								return value.Undefined, err
+							}
+						case <-done:
+							return value.Nil, value.Undefined
+						}
+					}
+				},
+				0,
+				nil,
+			)
+			thread := vm.GoNative(closure)
+			return value.Ref(thread), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+}
+
+// Std::Aborter signal constructors
+func initAborterSignals() {
+	c := &value.AborterClass.SingletonClass().MethodContainer
+	Def(
+		c,
+		"on_signal",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			signals, errVal := signalsArgument(vm, args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			var parent *value.Aborter
+			if args[2].IsNotUndefined() {
+				parent = args[2].AsReference().(*value.Aborter)
+			} else {
+				parent = value.GLOBAL_ABORTER
+			}
+
+			aborter := value.NewCancelAborter(parent)
+			ch := notifySignals(signals)
+			go func() {
+				defer stopSignals(ch)
+
+				select {
+				case <-ch:
+					aborter.CancelFunc()()
+				case <-aborter.Context().Done():
+				}
+			}()
+			return aborter.ToValue(), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+}
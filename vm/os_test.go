@@ -0,0 +1,115 @@
+package vm_test
+
+import (
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/elk-language/elk"
+	"github.com/elk-language/elk/types/checker"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
+)
+
+func TestOSArgsAndAtExit(t *testing.T) {
+	elk.InitGlobalEnvironment()
+	typechecker := checker.New()
+	chunk, compileErr := typechecker.CheckSourceBytecode(testFileName, `
+		OS.at_exit -> println "first registered"
+		OS.at_exit -> println "second registered"
+		println OS.args.inspect
+	`)
+	if compileErr.IsFailure() {
+		t.Fatalf("Compile Error: %s", compileErr.Error())
+	}
+
+	vm.SetArgs([]string{"foo", "bar"})
+	defer vm.SetArgs(nil)
+
+	var stdout strings.Builder
+	v := vm.New(vm.WithStdout(&stdout))
+	_, err := v.InterpretTopLevel(chunk)
+	if !err.IsUndefined() {
+		t.Fatalf("unexpected error: %s", err.Inspect())
+	}
+	if want := "[\"foo\", \"bar\"]\n"; stdout.String() != want {
+		t.Fatalf("invalid stdout before exit, want: %q, got: %q", want, stdout.String())
+	}
+
+	v.RunAtExitHooks()
+	v.RunAtExitHooks()
+	if want := "[\"foo\", \"bar\"]\nsecond registered\nfirst registered\n"; stdout.String() != want {
+		t.Fatalf("invalid stdout after exit, want: %q, got: %q", want, stdout.String())
+	}
+}
+
+func TestOSSignals(t *testing.T) {
+	tests := map[string]string{
+		"receive signals through a channel": `
+			signals := OS.signals(:hup)
+			select
+			case received := <<signals
+				println received.unwrap.inspect
+			end
+			signals.close
+		`,
+		"handle signals in a thread": `
+			handler := |signal: Symbol| ->
+				println signal.inspect
+				Thread.current.cancel
+			end
+			thread := OS.on_signal([:hup, :quit], handler)
+			thread.join(5.seconds)
+			println thread.error.inspect
+		`,
+		"close an aborter": `
+			aborter := Aborter.on_signal(:hup)
+			<<aborter.closed
+			println ":hup"
+			println aborter.is_closed.inspect
+		`,
+	}
+	wantStdout := map[string]string{
+		"receive signals through a channel": ":hup\n",
+		"handle signals in a thread":        ":hup\nnil\n",
+		"close an aborter":                  ":hup\ntrue\n",
+	}
+
+	for name, source := range tests {
+		t.Run(name, func(t *testing.T) {
+			elk.InitGlobalEnvironment()
+			typechecker := checker.New()
+			chunk, compileErr := typechecker.CheckSourceBytecode(testFileName, source)
+			if compileErr.IsFailure() {
+				t.Fatalf("Compile Error: %s", compileErr.Error())
+			}
+
+			stdout := newConcurrentStringBuilder()
+			v := vm.New(vm.WithStdout(stdout))
+			done := make(chan value.Value)
+			go func() {
+				_, err := v.InterpretTopLevel(chunk)
+				done <- err
+			}()
+
+			deadline := time.Now().Add(5 * time.Second)
+			for !vm.SimulateSignal(syscall.SIGHUP) {
+				if time.Now().After(deadline) {
+					t.Fatal("the signal has not been subscribed to")
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			if err := <-done; !err.IsUndefined() {
+				t.Fatalf("unexpected error: %s", err.Inspect())
+			}
+			if stdout.String() != wantStdout[name] {
+				t.Fatalf("invalid stdout, want: %q, got: %q", wantStdout[name], stdout.String())
+			}
+			if vm.SimulateSignal(syscall.SIGHUP) {
+				t.Fatal("the signal should have been unsubscribed")
+			}
+		})
+	}
+}
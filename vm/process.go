@@ -0,0 +1,409 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Signals that can be sent and received by Elk programs.
+var signalsByName = map[value.Symbol]os.Signal{
+	value.ToSymbol("int"):  syscall.SIGINT,
+	value.ToSymbol("term"): syscall.SIGTERM,
+	value.ToSymbol("hup"):  syscall.SIGHUP,
+	value.ToSymbol("quit"): syscall.SIGQUIT,
+	value.ToSymbol("kill"): syscall.SIGKILL,
+}
+
+// Returns the signal represented by a symbol like `:int` or `:term`.
+func signalArgument(arg value.Value) (os.Signal, value.Value) {
+	sig, ok := signalsByName[arg.AsInlineSymbol()]
+	if !ok {
+		return nil, value.Ref(value.NewError(
+			value.OutOfRangeErrorClass,
+			fmt.Sprintf("invalid signal: %s", arg.Inspect()),
+		))
+	}
+	return sig, value.Undefined
+}
+
+// Returns the aborter given as an optional argument
+// or the aborter of the thread.
+func (vm *Thread) aborterArgument(arg value.Value) *value.Aborter {
+	if !arg.IsUndefined() && !arg.IsNil() {
+		return arg.AsReference().(*value.Aborter)
+	}
+	if vm.Aborter != nil {
+		return vm.Aborter
+	}
+	return value.GLOBAL_ABORTER
+}
+
+// Build a command from the arguments of `Process.run` and `Process.spawn`:
+// the command name, its arguments, additional environment variables
+// and the working directory.
+func processCommand(vm *Thread, ctx context.Context, command, cmdArgs, env, cwd value.Value) (*exec.Cmd, value.Value) {
+	var argStrings []string
+	if !cmdArgs.IsUndefined() && !cmdArgs.IsNil() {
+		for arg, err := range Iterate(vm, cmdArgs) {
+			if !err.IsUndefined() {
+				return nil, err
+			}
+			argStrings = append(argStrings, arg.AsString().String())
+		}
+	}
+
+	var extraEnv map[string]string
+	if !env.IsUndefined() && !env.IsNil() {
+		extraEnv = make(map[string]string)
+		for element, err := range Iterate(vm, env) {
+			if !err.IsUndefined() {
+				return nil, err
+			}
+			pair := element.AsReference().(value.Pair)
+			extraEnv[pair.Key().AsString().String()] = pair.Value().AsString().String()
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, command.AsString().String(), argStrings...)
+	cmd.Env = value.ProcessEnviron(extraEnv)
+	if !cwd.IsUndefined() && !cwd.IsNil() {
+		cmd.Dir = pathArgument(cwd)
+	}
+	return cmd, value.Undefined
+}
+
+// Returns the standard input of a process given as a string or a reader.
+// Elk readers are read to the end on the current thread.
+func processStdin(vm *Thread, arg value.Value) (io.Reader, value.Value) {
+	if arg.IsUndefined() || arg.IsNil() {
+		return nil, value.Undefined
+	}
+	if content, ok := arg.SafeAsReference().(value.String); ok {
+		return strings.NewReader(string(content)), value.Undefined
+	}
+
+	reader := newIOReader(vm, arg)
+	if reader.native != nil {
+		return reader.native, value.Undefined
+	}
+	content, err := value.ReadString(reader, -1)
+	if err != nil {
+		return nil, ioErrorValue(err)
+	}
+	return strings.NewReader(content), value.Undefined
+}
+
+// Execute the command, wait for it to exit and capture its output.
+// The context has to be the one used to create the command.
+func runProcess(ctx context.Context, cmd *exec.Cmd) (value.Value, value.Value) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		if ctx.Err() != nil {
+			return value.Undefined, value.ExecutionAbortedError.ToValue()
+		}
+		if _, ok := err.(*exec.ExitError); !ok {
+			return value.Undefined, value.NewProcessError(err)
+		}
+	}
+
+	result := &value.ProcessResult{
+		Status: cmd.ProcessState.ExitCode(),
+		Stdout: stdout.String(),
+		Stderr: stderr.String(),
+	}
+	return value.Ref(result), value.Undefined
+}
+
+// Std::Process
+func initProcess() {
+	// Singleton methods
+	c := &value.ProcessClass.SingletonClass().MethodContainer
+	Def(
+		c,
+		"run",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			ctx := vm.aborterArgument(args[6]).Context()
+			cmd, errVal := processCommand(vm, ctx, args[1], args[2], args[3], args[4])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			cmd.Stdin, errVal = processStdin(vm, args[5])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+
+			return runProcess(ctx, cmd)
+		},
+		DefWithParameters(6),
+	)
+	Def(
+		c,
+		"run_async",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			aborter := vm.aborterArgument(args[6])
+			cmd, errVal := processCommand(vm, aborter.Context(), args[1], args[2], args[3], args[4])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			cmd.Stdin, errVal = processStdin(vm, args[5])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+
+			p := DefaultIOReactor.Submit(vm.threadPool, aborter, func(ctx context.Context) (value.Value, value.Value) {
+				return runProcess(ctx, cmd)
+			})
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(7),
+	)
+	Def(
+		c,
+		"spawn",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			ctx := vm.aborterArgument(args[5]).Context()
+			cmd, errVal := processCommand(vm, context.Background(), args[1], args[2], args[3], args[4])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+
+			process, err := value.StartProcess(ctx, cmd)
+			if err != nil {
+				return value.Undefined, value.NewProcessError(err)
+			}
+			return value.Ref(process), value.Undefined
+		},
+		DefWithParameters(5),
+	)
+
+	// Instance methods
+	c = &value.ProcessClass.MethodContainer
+	Def(
+		c,
+		"command",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			return value.Ref(value.String(self.Command)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"pid",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			return value.SmallInt(self.Pid()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stdin",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			return value.Ref(self.Stdin), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stdout",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			return value.Ref(self.Stdout), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stderr",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			return value.Ref(self.Stderr), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			select {
+			case <-self.Exited():
+			case <-vm.aborterArgument(value.Undefined).Context().Done():
+				return value.Undefined, value.ExecutionAbortedError.ToValue()
+			}
+			code, err := self.Wait()
+			if err != nil {
+				return value.Undefined, value.NewProcessError(err)
+			}
+			return value.SmallInt(code).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait_async",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			aborter := vm.Aborter
+			if aborter == nil {
+				aborter = value.GLOBAL_ABORTER
+			}
+
+			p := NewExternalPromise(vm.threadPool)
+			stopAbort := context.AfterFunc(aborter.Context(), func() {
+				p.Reject(value.ExecutionAbortedError.ToValue(), nil)
+			})
+			self.OnExit(func() {
+				stopAbort()
+				code, err := self.ExitCode()
+				if err != nil {
+					p.Reject(value.NewProcessError(err), nil)
+					return
+				}
+				p.Resolve(value.SmallInt(code).ToValue())
+			})
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"close",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			self.Close()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"kill",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			err := self.Signal(os.Kill)
+			if err != nil {
+				return value.Undefined, value.NewProcessError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"signal",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Process)(args[0].Pointer())
+			sig, errVal := signalArgument(args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			err := self.Signal(sig)
+			if err != nil {
+				return value.Undefined, value.NewProcessError(err)
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
+
+// Std::Process::Result
+func initProcessResult() {
+	c := &value.ProcessResultClass.MethodContainer
+	Def(
+		c,
+		"status",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.ProcessResult)(args[0].Pointer())
+			return value.SmallInt(self.Status).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stdout",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.ProcessResult)(args[0].Pointer())
+			return value.Ref(value.String(self.Stdout)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stderr",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.ProcessResult)(args[0].Pointer())
+			return value.Ref(value.String(self.Stderr)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_success",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.ProcessResult)(args[0].Pointer())
+			return value.BoolVal(self.IsSuccess()), value.Undefined
+		},
+	)
+}
+
+// Std::Process::PipeReader
+func initPipeReader() {
+	c := &value.ProcessPipeReaderClass.MethodContainer
+	Def(
+		c,
+		"read",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.PipeReader)(args[0].Pointer())
+			limit, errVal := readLimitArgument(args[1])
+			if !errVal.IsUndefined() {
+				return value.Undefined, errVal
+			}
+			content, err := value.ReadString(self, limit)
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.Ref(value.String(content)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"close",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.PipeReader)(args[0].Pointer())
+			self.Close()
+			return value.Nil, value.Undefined
+		},
+	)
+}
+
+// Std::Process::PipeWriter
+func initPipeWriter() {
+	c := &value.ProcessPipeWriterClass.MethodContainer
+	Def(
+		c,
+		"write",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.PipeWriter)(args[0].Pointer())
+			n, err := io.WriteString(self, args[1].AsString().String())
+			if err != nil {
+				return value.Undefined, ioErrorValue(err)
+			}
+			return value.SmallInt(n).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"close",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.PipeWriter)(args[0].Pointer())
+			self.Close()
+			return value.Nil, value.Undefined
+		},
+	)
+}
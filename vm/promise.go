@@ -3,8 +3,11 @@ package vm
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
 )
 
 type NativePromiseBody struct {
@@ -29,21 +32,32 @@ func (*NativePromiseBody) promiseBody() {}
 type Promise struct {
 	Body          PromiseBody
 	ThreadPool    *ThreadPool
+	Aborter       *value.Aborter // aborter used by the body of the promise, nil when the promise cannot be aborted
 	continuations []*Promise
 	result        value.Value
 	stackTrace    *value.StackTrace
 	err           value.Value
 	wg            sync.WaitGroup // the wait group hits 0 when the promise is resolved, used for waiting for a promise
 	m             sync.Mutex
+	debug         promiseDebugInfo // used for detecting leaks in debug builds
 }
 
 // Create a new promise executed by the VM
 func NewPromise(threadPool *ThreadPool, generator *Generator) *Promise {
+	return NewPromiseWithAborter(threadPool, generator, nil)
+}
+
+// Create a new promise executed by the VM.
+// The body of the promise gets executed with the given aborter,
+// so it can be cancelled.
+func NewPromiseWithAborter(threadPool *ThreadPool, generator *Generator, aborter *value.Aborter) *Promise {
 	p := &Promise{
 		ThreadPool: threadPool,
 		Body:       generator,
+		Aborter:    aborter,
 	}
 	p.wg.Add(1)
+	trackPromise(p, false)
 
 	threadPool.AddTask(p)
 	return p
@@ -58,6 +72,7 @@ func NewBytecodePromise(threadPool *ThreadPool, bytecode *BytecodeFunction, args
 		Body:       generator,
 	}
 	p.wg.Add(1)
+	trackPromise(p, false)
 
 	threadPool.AddTask(p)
 	return p
@@ -65,11 +80,20 @@ func NewBytecodePromise(threadPool *ThreadPool, bytecode *BytecodeFunction, args
 
 // Create a new native promise.
 func NewNativePromise(threadPool *ThreadPool, fn NativeFunction, args ...value.Value) *Promise {
+	return NewNativePromiseWithAborter(threadPool, nil, fn, args...)
+}
+
+// Create a new native promise.
+// The function gets executed with the given aborter,
+// so it can be cancelled.
+func NewNativePromiseWithAborter(threadPool *ThreadPool, aborter *value.Aborter, fn NativeFunction, args ...value.Value) *Promise {
 	p := &Promise{
 		ThreadPool: threadPool,
 		Body:       NewNativePromiseBody(fn, args...),
+		Aborter:    aborter,
 	}
 	p.wg.Add(1)
+	trackPromise(p, false)
 
 	threadPool.AddTask(p)
 	return p
@@ -83,6 +107,7 @@ func NewExternalPromise(threadPool *ThreadPool) *Promise {
 		ThreadPool: threadPool,
 	}
 	p.wg.Add(1)
+	trackPromise(p, true)
 	return p
 }
 
@@ -169,52 +194,146 @@ func (p *Promise) RegisterContinuationUnsafe(continuation *Promise) {
 
 func (p *Promise) ResolveReject(result, err value.Value) {
 	p.m.Lock()
+	if p.IsResolved() {
+		p.m.Unlock()
+		return
+	}
 
-	queue := p.ThreadPool.TaskQueue
+	untrackPromise(p)
+	p.releaseAborter()
 	p.Body = nil
 	p.ThreadPool = nil
 	p.result = result
 	p.err = err
 	p.wg.Done()
-	p.enqueueContinuations(queue)
+	p.enqueueContinuations()
 
 	p.m.Unlock()
 }
 
+// Resolve the promise with the given result.
+// Does nothing when the promise has already been settled.
 func (p *Promise) Resolve(result value.Value) {
 	p.m.Lock()
+	if p.IsResolved() {
+		p.m.Unlock()
+		return
+	}
 
-	queue := p.ThreadPool.TaskQueue
+	untrackPromise(p)
+	p.releaseAborter()
 	p.Body = nil
 	p.ThreadPool = nil
 	p.result = result
 	p.wg.Done()
-	p.enqueueContinuations(queue)
+	p.enqueueContinuations()
 
 	p.m.Unlock()
 }
 
+// Reject the promise with the given error.
+// Does nothing when the promise has already been settled.
 func (p *Promise) Reject(err value.Value, stackTrace *value.StackTrace) {
 	p.m.Lock()
+	if p.IsResolved() {
+		p.m.Unlock()
+		return
+	}
 
-	queue := p.ThreadPool.TaskQueue
+	untrackPromise(p)
+	p.releaseAborter()
 	p.Body = nil
 	p.ThreadPool = nil
 	p.err = err
 	p.stackTrace = stackTrace
 	p.wg.Done()
-	p.enqueueContinuations(queue)
+	p.enqueueContinuations()
 
 	p.m.Unlock()
 }
 
-func (p *Promise) enqueueContinuations(queue chan *Promise) {
+// Detach the aborter of a settled promise from its parent,
+// so that it can be garbage collected with the promise.
+func (p *Promise) releaseAborter() {
+	if p.Aborter != nil {
+		p.Aborter.Release()
+	}
+}
+
+// Put the promises waiting for this promise
+// back in the queues of their thread pools.
+func (p *Promise) enqueueContinuations() {
 	for _, cont := range p.continuations {
-		queue <- cont
+		cont.ThreadPool.TaskQueue <- cont
 	}
 	p.continuations = nil
 }
 
+// Settle the promise with the outcome of another, settled promise.
+func (p *Promise) settleWith(other *Promise) {
+	if !other.err.IsUndefined() {
+		p.Reject(other.err, other.stackTrace)
+		return
+	}
+
+	p.Resolve(other.result)
+}
+
+// Returns the body of the promise,
+// nil when it has already been settled.
+func (p *Promise) body() PromiseBody {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.Body
+}
+
+// Cancel the promise.
+// Closes the aborter of the body of the promise,
+// the body terminates with an `execution aborted` error at its next safe point.
+// Promises without an aborter get rejected immediately.
+func (p *Promise) Cancel() {
+	if p.Aborter != nil {
+		p.Aborter.Close()
+		return
+	}
+
+	p.Reject(value.ExecutionAbortedError.ToValue(), nil)
+}
+
+// Execute the given function in a thread worker of the pool
+// after the promise has been settled, the function receives the settled promise.
+// Returns a new promise that gets settled with the result of the function.
+//
+// The continuation gets registered on the promise,
+// so no thread is blocked while waiting.
+func (p *Promise) Continue(threadPool *ThreadPool, fn func(thread *Thread, settled *Promise) (value.Value, value.Value)) *Promise {
+	cont := &Promise{
+		ThreadPool: threadPool,
+		Body: NewNativePromiseBody(func(thread *Thread, _ []value.Value) (value.Value, value.Value) {
+			return fn(thread, p)
+		}),
+	}
+	cont.wg.Add(1)
+	trackPromise(cont, false)
+
+	if !threadPool.reserveTask() {
+		cont.Reject(value.Ref(threadPoolClosedError()), nil)
+		return cont
+	}
+
+	p.m.Lock()
+	if p.IsResolved() {
+		p.m.Unlock()
+		threadPool.TaskQueue <- cont
+		return cont
+	}
+	p.continuations = append(p.continuations, cont)
+	p.m.Unlock()
+
+	return cont
+}
+
 func initPromise() {
 	// Singleton methods
 	c := &value.PromiseClass.SingletonClass().MethodContainer
@@ -264,6 +383,98 @@ func initPromise() {
 		},
 		DefWithParameters(1),
 	)
+	Def(
+		c,
+		"all_settled",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			promises, err := collectPromises(vm, args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			if len(promises) == 0 {
+				return value.Ref(NewResolvedPromise(value.Ref(value.NewArrayListOfValue(0)))), value.Undefined
+			}
+
+			p := NewExternalPromise(vm.threadPool)
+			results := make([]value.Value, len(promises))
+			var remaining atomic.Int64
+			remaining.Store(int64(len(promises)))
+			for i, promise := range promises {
+				promise.Continue(vm.threadPool, func(_ *Thread, settled *Promise) (value.Value, value.Value) {
+					results[i] = value.MakeResult2(settled.result, settled.err).ToValue()
+					if remaining.Add(-1) == 0 {
+						p.Resolve(value.Ref(value.NewArrayListOfValueWithElements(0, results...)))
+					}
+					return value.Nil, value.Undefined
+				})
+			}
+
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"race",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			promises, err := collectPromises(vm, args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+
+			if len(promises) == 0 {
+				return value.Undefined, value.Ref(value.NewError(
+					value.OutOfRangeErrorClass,
+					"cannot race an empty collection of promises",
+				))
+			}
+
+			p := NewExternalPromise(vm.threadPool)
+			for _, promise := range promises {
+				promise.Continue(vm.threadPool, func(_ *Thread, settled *Promise) (value.Value, value.Value) {
+					p.settleWith(settled)
+					return value.Nil, value.Undefined
+				})
+			}
+
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"any",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			promises, err := collectPromises(vm, args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			if len(promises) == 0 {
+				return value.Ref(NewRejectedPromise(newAggregateError(nil))), value.Undefined
+			}
+
+			p := NewExternalPromise(vm.threadPool)
+			errors := make([]value.Value, len(promises))
+			var rejected atomic.Int64
+			for i, promise := range promises {
+				promise.Continue(vm.threadPool, func(_ *Thread, settled *Promise) (value.Value, value.Value) {
+					if settled.err.IsUndefined() {
+						p.Resolve(settled.result)
+						return value.Nil, value.Undefined
+					}
+
+					errors[i] = settled.err
+					if rejected.Add(1) == int64(len(errors)) {
+						p.Reject(newAggregateError(errors), nil)
+					}
+					return value.Nil, value.Undefined
+				})
+			}
+
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(1),
+	)
 
 	// Instance methods
 	c = &value.PromiseClass.MethodContainer
@@ -275,4 +486,190 @@ func initPromise() {
 			return value.BoolVal(self.IsResolved()), value.Undefined
 		},
 	)
+	Def(
+		c,
+		"with_timeout",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Promise)(args[0].Pointer())
+			timeout := args[1].AsTimeSpan()
+			cancel := !args[2].IsUndefined() && value.Truthy(args[2])
+
+			p := NewExternalPromise(vm.threadPool)
+			timer := time.AfterFunc(timeout.Native(), func() {
+				p.Reject(
+					value.Ref(value.Errorf(
+						value.PromiseTimeoutErrorClass,
+						"promise has not been settled in %s",
+						timeout.Inspect(),
+					)),
+					nil,
+				)
+				if cancel {
+					self.Cancel()
+				}
+			})
+			self.Continue(vm.threadPool, func(_ *Thread, settled *Promise) (value.Value, value.Value) {
+				timer.Stop()
+				p.settleWith(settled)
+				return value.Nil, value.Undefined
+			})
+
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"then",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Promise)(args[0].Pointer())
+			fn := args[1]
+
+			threadPool := vm.threadPool
+			p := NewExternalPromise(threadPool)
+			self.Continue(threadPool, func(thread *Thread, settled *Promise) (value.Value, value.Value) {
+				if !settled.err.IsUndefined() {
+					p.settleWith(settled)
+					return value.Nil, value.Undefined
+				}
+
+				result, err := thread.CallCallable(fn, settled.result)
+				if !err.IsUndefined() {
+					p.Reject(err, nil)
+					return value.Nil, value.Undefined
+				}
+
+				next, ok := result.SafeAsReference().(*Promise)
+				if !ok {
+					p.Reject(
+						value.Ref(value.Errorf(
+							value.TypeErrorClass,
+							"`then` callback should return a promise, got `%s`",
+							result.Class().PrintableName(),
+						)),
+						nil,
+					)
+					return value.Nil, value.Undefined
+				}
+				next.Continue(threadPool, func(_ *Thread, settled *Promise) (value.Value, value.Value) {
+					p.settleWith(settled)
+					return value.Nil, value.Undefined
+				})
+				return value.Nil, value.Undefined
+			})
+
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"map",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Promise)(args[0].Pointer())
+			fn := args[1]
+
+			p := NewExternalPromise(vm.threadPool)
+			self.Continue(vm.threadPool, func(thread *Thread, settled *Promise) (value.Value, value.Value) {
+				if !settled.err.IsUndefined() {
+					p.settleWith(settled)
+					return value.Nil, value.Undefined
+				}
+
+				result, err := thread.CallCallable(fn, settled.result)
+				if !err.IsUndefined() {
+					p.Reject(err, nil)
+					return value.Nil, value.Undefined
+				}
+				p.Resolve(result)
+				return value.Nil, value.Undefined
+			})
+
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"catch",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Promise)(args[0].Pointer())
+			fn := args[1]
+
+			p := NewExternalPromise(vm.threadPool)
+			self.Continue(vm.threadPool, func(thread *Thread, settled *Promise) (value.Value, value.Value) {
+				if settled.err.IsUndefined() {
+					p.settleWith(settled)
+					return value.Nil, value.Undefined
+				}
+
+				result, err := thread.CallCallable(fn, settled.err)
+				if !err.IsUndefined() {
+					p.Reject(err, nil)
+					return value.Nil, value.Undefined
+				}
+				p.Resolve(result)
+				return value.Nil, value.Undefined
+			})
+
+			return value.Ref(p), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"cancel",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Promise)(args[0].Pointer())
+			self.Cancel()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"aborter",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Promise)(args[0].Pointer())
+			if self.Aborter == nil {
+				return value.Nil, value.Undefined
+			}
+			return value.Ref(self.Aborter), value.Undefined
+		},
+	)
+
+	// ::Std::Promise::AggregateError
+	c = &value.PromiseAggregateErrorClass.MethodContainer
+	DefineGetter(c, symbol.L_errors, 1)
+}
+
+// Collect the promises from the given collection.
+func collectPromises(vm *Thread, collection value.Value) ([]*Promise, value.Value) {
+	var promises []*Promise
+	for val, err := range Iterate(vm, collection) {
+		if !err.IsUndefined() {
+			return nil, err
+		}
+		promise, ok := val.SafeAsReference().(*Promise)
+		if !ok {
+			return nil, value.Ref(value.Errorf(
+				value.TypeErrorClass,
+				"expected a promise, got `%s`",
+				val.Class().PrintableName(),
+			))
+		}
+		promises = append(promises, promise)
+	}
+
+	return promises, value.Undefined
+}
+
+// Create a new `Std::Promise::AggregateError` with the given errors.
+func newAggregateError(errors []value.Value) value.Value {
+	return value.Ref(value.NewObject(
+		value.ObjectWithClass(value.PromiseAggregateErrorClass),
+		value.ObjectWithInstanceVariablesByName(value.SymbolMap{
+			symbol.L_message: value.String("all promises have been rejected").ToValue(),
+			symbol.L_errors:  value.Ref(value.NewArrayListOfValueWithElements(0, errors...)),
+		}),
+	))
 }
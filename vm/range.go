@@ -0,0 +1,45 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Range
+func initRange() {
+	// Instance methods
+	c := &value.RangeMixin.MethodContainer
+	defParallelIterableMethods(c)
+}
+
+// Returns the first and last index included in a range of integers
+// that is used to slice a collection of the given length.
+// Missing bounds of beginless and endless ranges
+// default to the first and last index of the collection.
+func rangeIndexBounds(rangeVal value.Reference, length int) (start, end int) {
+	end = length - 1
+
+	switch r := rangeVal.(type) {
+	case *value.ClosedRange:
+		start = r.Start.AsInt()
+		end = r.End.AsInt()
+	case *value.LeftOpenRange:
+		start = r.Start.AsInt() + 1
+		end = r.End.AsInt()
+	case *value.RightOpenRange:
+		start = r.Start.AsInt()
+		end = r.End.AsInt() - 1
+	case *value.OpenRange:
+		start = r.Start.AsInt() + 1
+		end = r.End.AsInt() - 1
+	case *value.BeginlessOpenRange:
+		end = r.End.AsInt() - 1
+	case *value.BeginlessClosedRange:
+		end = r.End.AsInt()
+	case *value.EndlessOpenRange:
+		start = r.Start.AsInt() + 1
+	case *value.EndlessClosedRange:
+		start = r.Start.AsInt()
+	}
+
+	return start, end
+}
@@ -17,6 +17,15 @@ func initRegex() {
 		},
 		DefWithParameters(1),
 	)
+	Def(
+		c,
+		"=~",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			return self.LaxEqual(args[1])
+		},
+		DefWithParameters(1),
+	)
 	Def(
 		c,
 		"+",
@@ -51,5 +60,304 @@ func initRegex() {
 		DefWithParameters(1),
 		DefWithOptionalParameters(1),
 	)
+	Def(
+		c,
+		"match",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			str := args[1].AsString()
+			match, err := self.Match(string(str))
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			if match == nil {
+				return value.Nil, value.Undefined
+			}
+			return value.Ref(match), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"find_all",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			str := args[1].AsString()
+			iter, err := value.NewRegexMatchIterator(self, string(str))
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Ref(iter), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Alias(c, "scan", "find_all")
+	Def(
+		c,
+		"replace",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			str := args[1].AsString()
+			template := args[2].MustReference().(value.String)
+			return value.RefErr(regexReplaceTemplate(self, string(str), string(template), false))
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"replace@1",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			str := args[1].AsString()
+			return value.RefErr(regexReplaceFunc(vm, self, string(str), args[2], false))
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"replace_all",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			str := args[1].AsString()
+			template := args[2].MustReference().(value.String)
+			return value.RefErr(regexReplaceTemplate(self, string(str), string(template), true))
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"replace_all@1",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			str := args[1].AsString()
+			return value.RefErr(regexReplaceFunc(vm, self, string(str), args[2], true))
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"split",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(*value.Regex)
+			str := args[1].AsString()
+			limit := -1
+			if !args[2].IsUndefined() {
+				limit = args[2].AsInt()
+			}
+			return value.RefErr(str.Split(value.Ref(self), limit))
+		},
+		DefWithParameters(2),
+	)
+}
+
+// Replace matches of the regex with a template string.
+func regexReplaceTemplate(re *value.Regex, str, template string, all bool) (value.String, value.Value) {
+	return re.ReplaceFunc(str, all, func(match *value.RegexMatch) (string, value.Value) {
+		return match.Expand(template), value.Undefined
+	})
+}
+
+// Replace matches of the regex with the results of calling a function with every match.
+func regexReplaceFunc(vm *Thread, re *value.Regex, str string, replacement value.Value, all bool) (value.String, value.Value) {
+	return re.ReplaceFunc(str, all, func(match *value.RegexMatch) (string, value.Value) {
+		result, err := vm.CallCallable(replacement, value.Ref(match))
+		if !err.IsUndefined() {
+			return "", err
+		}
+		result, err = ToString(vm, result)
+		if !err.IsUndefined() {
+			return "", err
+		}
+		return result.AsString().String(), value.Undefined
+	})
+}
+
+// Returns the value of an optional `Int`
+// or nil when the capture group did not participate in the match.
+func optionalRegexOffset(offset int, ok bool, err value.Value) (value.Value, value.Value) {
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+	if !ok {
+		return value.Nil, value.Undefined
+	}
+	return value.SmallInt(offset).ToValue(), value.Undefined
+}
+
+// ::Std::Regex::Match
+func initRegexMatch() {
+	// Instance methods
+	c := &value.RegexMatchClass.MethodContainer
+	Def(
+		c,
+		"regex",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return value.Ref(self.Regex), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"string",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return value.Ref(value.String(self.Subject)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"to_string",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return value.Ref(value.String(self.String())), value.Undefined
+		},
+	)
+	Alias(c, "value", "to_string")
+	Def(
+		c,
+		"[]",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return self.GroupVal(args[1])
+		},
+		DefWithParameters(1),
+	)
+	Alias(c, "group", "[]")
+	Def(
+		c,
+		"group_count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return value.SmallInt(self.GroupCount()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"captures",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return value.Ref(self.Captures()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"named_captures",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			names := self.Regex.SubexpNames()
+
+			hmap := NewHashMapOfValue(len(names))
+			for i, name := range names {
+				if name == "" {
+					continue
+				}
 
+				capture := value.Nil
+				if str, ok := self.Group(i); ok {
+					capture = value.Ref(value.String(str))
+				}
+				err := HashMapOfValueSet(vm, hmap, value.Ref(value.String(name)), capture)
+				if !err.IsUndefined() {
+					return value.Undefined, err
+				}
+			}
+			return value.Ref(hmap), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"byte_start",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			start, _, ok, err := self.ByteBounds(args[1])
+			return optionalRegexOffset(start, ok, err)
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"byte_end",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			_, end, ok, err := self.ByteBounds(args[1])
+			return optionalRegexOffset(end, ok, err)
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"char_start",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			start, _, ok, err := self.CharBounds(args[1])
+			return optionalRegexOffset(start, ok, err)
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"char_end",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			_, end, ok, err := self.CharBounds(args[1])
+			return optionalRegexOffset(end, ok, err)
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"before",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return value.Ref(value.String(self.Before())), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"after",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			return value.Ref(value.String(self.After())), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"expand",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatch)(args[0].Pointer())
+			template := args[1].AsString()
+			return value.Ref(value.String(self.Expand(string(template)))), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
+
+// ::Std::Regex::MatchIterator
+func initRegexMatchIterator() {
+	// Instance methods
+	c := &value.RegexMatchIteratorClass.MethodContainer
+	Def(
+		c,
+		"next",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatchIterator)(args[0].Pointer())
+			return self.NextValue()
+		},
+	)
+	Def(
+		c,
+		"iter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return args[0], value.Undefined
+		},
+	)
+	Def(
+		c,
+		"reset",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.RegexMatchIterator)(args[0].Pointer())
+			self.Reset()
+			return args[0], value.Undefined
+		},
+	)
 }
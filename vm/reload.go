@@ -0,0 +1,110 @@
+package vm
+
+import (
+	"sync/atomic"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Set when methods can be redefined while the program is running.
+// Enables synchronised method tables, call cache invalidation
+// and forwarding of direct calls to reloaded methods.
+var hotReloadEnabled bool
+
+// Enable redefining methods while the program is running.
+// Should be called before any code gets executed.
+func EnableHotReload() {
+	hotReloadEnabled = true
+	value.GuardMethodTables = true
+}
+
+// Incremented every time call caches get invalidated.
+// Cached call sites from previous epochs are treated as empty.
+// Only used when hot reloading is enabled.
+var callCacheEpoch atomic.Uint64
+
+// Invalidate every inline call cache in the VM.
+// Should be used after methods have been redefined
+// in classes that have already been used.
+func InvalidateCallCaches() {
+	callCacheEpoch.Add(1)
+}
+
+// A method definition collected by a thread
+// that executes bytecode in reload mode.
+type methodDefinition struct {
+	container *value.MethodContainer
+	name      value.Symbol
+	method    value.Method
+}
+
+// Define a method in the given container.
+// In reload mode the definition gets collected
+// and is applied later.
+func (vm *Thread) defineMethod(container *value.MethodContainer, name value.Symbol, method value.Method) {
+	if vm.reloadedMethods != nil {
+		*vm.reloadedMethods = append(*vm.reloadedMethods, methodDefinition{
+			container: container,
+			name:      name,
+			method:    method,
+		})
+		return
+	}
+
+	container.AttachMethod(name, method)
+}
+
+// Execute bytecode that defines methods (like `<methodDefinitions>`)
+// and swap the new methods into the live method tables at once.
+//
+// `changed` reports whether a bytecode method comes from a modified source file.
+// Unchanged bytecode methods are not swapped in,
+// the running program keeps its current versions.
+// Direct calls to replaced bytecode methods get forwarded
+// to the new versions and call caches are invalidated.
+//
+// Hot reloading has to be enabled with `EnableHotReload`.
+func ReloadMethods(methodDefinitions *BytecodeFunction, changed func(*BytecodeFunction) bool) value.Value {
+	var definitions []methodDefinition
+	thread := New()
+	thread.reloadedMethods = &definitions
+	_, err := thread.InterpretTopLevel(methodDefinitions)
+	if !err.IsUndefined() {
+		return err
+	}
+
+	value.LockMethodTables()
+	defer value.UnlockMethodTables()
+
+	for _, definition := range definitions {
+		oldMethod := definition.container.Methods[definition.name]
+		oldFn, oldIsBytecode := oldMethod.(*BytecodeFunction)
+		newFn, newIsBytecode := definition.method.(*BytecodeFunction)
+
+		if oldIsBytecode && newIsBytecode {
+			if !changed(newFn) {
+				// call sites in the new code
+				// should use the live version of the method
+				newFn.replacement.Store(oldFn)
+				continue
+			}
+			oldFn.replacement.Store(newFn)
+		}
+		definition.container.Methods[definition.name] = definition.method
+	}
+
+	InvalidateCallCaches()
+	return value.Undefined
+}
+
+// Returns the newest version of the method
+// when it has been replaced during hot reloading.
+func (f *BytecodeFunction) current() *BytecodeFunction {
+	for {
+		next := f.replacement.Load()
+		if next == nil {
+			return f
+		}
+		f = next
+	}
+}
@@ -0,0 +1,90 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::Semaphore
+func initSemaphore() {
+	// Instance methods
+	c := &value.SemaphoreClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			permits, ok := value.ToGoInt(args[1])
+			if !ok || permits < 1 {
+				return value.Undefined, value.Ref(value.NewError(
+					value.OutOfRangeErrorClass,
+					fmt.Sprintf("invalid semaphore permit count: %s", args[1].Inspect()),
+				))
+			}
+			return value.Ref(value.NewSemaphore(permits)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"permits",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Semaphore)(args[0].Pointer())
+			return value.SmallInt(self.Permits()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"available_permits",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Semaphore)(args[0].Pointer())
+			return value.SmallInt(self.AvailablePermits()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"acquire",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Semaphore)(args[0].Pointer())
+			ctx, cancel := vm.abortContext(args[1])
+			defer cancel()
+
+			if err := self.Acquire(ctx); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"try_acquire",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Semaphore)(args[0].Pointer())
+			if args[1].IsUndefined() || args[1].IsNil() {
+				return value.BoolVal(self.TryAcquire()), value.Undefined
+			}
+
+			ctx, cancel := vm.abortContext(args[2])
+			defer cancel()
+
+			ok, err := self.TryAcquireTimeout(ctx, args[1].AsTimeSpan())
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.BoolVal(ok), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"release",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Semaphore)(args[0].Pointer())
+			if err := self.Release(); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+	)
+}
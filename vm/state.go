@@ -11,6 +11,7 @@ const (
 	errorState // the VM stopped after encountering an uncaught error
 	awaitState
 	terminatedState
+	preemptedState // the VM stopped executing a promise because its time slice ran out
 )
 
 var stateSymbols = [...]value.Symbol{
@@ -18,4 +19,5 @@ var stateSymbols = [...]value.Symbol{
 	runningState:    value.ToSymbol("running"),
 	errorState:      value.ToSymbol("error"),
 	terminatedState: value.ToSymbol("terminated"),
+	preemptedState:  value.ToSymbol("preempted"),
 }
@@ -4,10 +4,52 @@ import (
 	"github.com/elk-language/elk/value"
 )
 
+// Returns the index at which a search in a string starts.
+func stringSearchStart(arg value.Value) int {
+	if arg.IsUndefined() {
+		return 0
+	}
+	return arg.AsInt()
+}
+
+// Returns the bounds (`start..<end`) of a range of integers
+// used to slice a string of the given length.
+// Negative indices are counted from the end
+// and the bounds are clamped to the string.
+func stringSliceBounds(rangeVal value.Reference, length int) (start, end int) {
+	start, last := rangeIndexBounds(rangeVal, length)
+	if start < 0 {
+		start = max(start+length, 0)
+	}
+	if last < 0 {
+		last += length
+	}
+	return start, min(last+1, length)
+}
+
 // Std::String
 func initString() {
+	// Singleton methods
+	c := &value.StringClass.SingletonClass().MethodContainer
+	Def(
+		c,
+		"format",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			format := args[1].AsString()
+			var formatArgs []value.Value
+			for val, err := range Iterate(vm, args[2]) {
+				if !err.IsUndefined() {
+					return value.Undefined, err
+				}
+				formatArgs = append(formatArgs, val)
+			}
+			return value.RefErr(Format(vm, format.String(), formatArgs))
+		},
+		DefWithParameters(2),
+	)
+
 	// Instance methods
-	c := &value.StringClass.MethodContainer
+	c = &value.StringClass.MethodContainer
 	Def(
 		c,
 		"+",
@@ -43,6 +85,23 @@ func initString() {
 		DefWithParameters(1),
 	)
 	Alias(c, "repeat", "*")
+	Def(
+		c,
+		"%",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			var formatArgs []value.Value
+			if tuple, ok := args[1].SafeAsReference().(value.ArrayTuple); ok {
+				for _, val := range tuple.Elements() {
+					formatArgs = append(formatArgs, val)
+				}
+			} else {
+				formatArgs = []value.Value{args[1]}
+			}
+			return value.RefErr(Format(vm, self.String(), formatArgs))
+		},
+		DefWithParameters(1),
+	)
 	Def(
 		c,
 		"<=>",
@@ -93,6 +152,19 @@ func initString() {
 		},
 		DefWithParameters(1),
 	)
+	Def(
+		c,
+		"=~",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			equal, err := self.LaxEqualErr(args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.BoolVal(equal), value.Undefined
+		},
+		DefWithParameters(1),
+	)
 	Def(
 		c,
 		"==",
@@ -125,6 +197,234 @@ func initString() {
 		},
 		DefWithParameters(2),
 	)
+	Def(
+		c,
+		"center",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			len := args[1].AsInt()
+			padding := args[2].AsChar()
+			return value.Ref(self.Center(len, padding)), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"split",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			separator := args[1]
+			limit := -1
+			if !args[2].IsUndefined() {
+				limit = args[2].AsInt()
+			}
+			return value.RefErr(self.Split(separator, limit))
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"lines",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.Lines()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"replace",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			pattern := args[1]
+			replacement := args[2].MustReference().(value.String)
+			return value.RefErr(self.Replace(pattern, replacement))
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"replace_all",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			pattern := args[1]
+			replacement := args[2].MustReference().(value.String)
+			return value.RefErr(self.ReplaceAll(pattern, replacement))
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"trim",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.Trim()), value.Undefined
+		},
+	)
+	Alias(c, "strip", "trim")
+	Def(
+		c,
+		"trim_start",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.TrimStart()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"trim_end",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.TrimEnd()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"starts_with",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			result, err := self.StartsWith(args[1])
+			return value.BoolVal(result), err
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"ends_with",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			result, err := self.EndsWith(args[1])
+			return value.BoolVal(result), err
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"remove_prefix",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			other := args[1]
+			return value.RefErr(self.RemovePrefix(other))
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"contains",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			result, err := self.Contains(args[1])
+			return value.BoolVal(result), err
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"index_of",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			index, err := self.IndexOf(args[1], stringSearchStart(args[2]))
+			return value.SmallInt(index).ToValue(), err
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"byte_index_of",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			index, err := self.ByteIndexOf(args[1], stringSearchStart(args[2]))
+			return value.SmallInt(index).ToValue(), err
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"grapheme_index_of",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			index, err := self.GraphemeIndexOf(args[1], stringSearchStart(args[2]))
+			return value.SmallInt(index).ToValue(), err
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"last_index_of",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			index, err := self.LastIndexOf(args[1])
+			return value.SmallInt(index).ToValue(), err
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"reverse",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.ReverseChars()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"reverse_graphemes",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.ReverseGraphemes()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"capitalize",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.Capitalize()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"chars",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.Chars()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"graphemes",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.Graphemes()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"bytes",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			return value.Ref(self.Bytes()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"slice",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			start, end := stringSliceBounds(args[1].AsReference(), self.CharCount())
+			return value.Ref(self.SliceChars(start, end)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"grapheme_slice",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := args[0].MustReference().(value.String)
+			start, end := stringSliceBounds(args[1].AsReference(), self.GraphemeCount())
+			return value.Ref(self.SliceGraphemes(start, end)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
 	Def(
 		c,
 		"char_at",
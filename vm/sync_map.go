@@ -0,0 +1,552 @@
+package vm
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/elk-language/elk/value"
+)
+
+const syncMapShardCount = 32
+
+type syncMapShard struct {
+	mu         sync.RWMutex
+	m          *HashMapOfValue
+	insertions []*syncMapInsertion // insertions of `GetOrInsert` whose functions are being called
+}
+
+// An insertion of `GetOrInsert` whose function is being called.
+// Other callers with the same key wait for it
+// instead of calling their functions.
+type syncMapInsertion struct {
+	key    value.Value
+	thread *Thread       // thread that calls the function
+	done   chan struct{} // closed when the function returns
+	result value.Value   // value under the key, undefined when the function has failed
+}
+
+// A concurrent hash map split into shards
+// guarded by separate locks.
+type SyncMap struct {
+	shards [syncMapShardCount]syncMapShard
+}
+
+func NewSyncMap() *SyncMap {
+	m := &SyncMap{}
+	for i := range m.shards {
+		m.shards[i].m = NewHashMapOfValue(0)
+	}
+	return m
+}
+
+func (m *SyncMap) Copy() value.Reference {
+	return m
+}
+
+func (m *SyncMap) ToValue() value.Value {
+	return value.Ref(m)
+}
+
+func (*SyncMap) Class() *value.Class {
+	return value.SyncMapClass
+}
+
+func (*SyncMap) DirectClass() *value.Class {
+	return value.SyncMapClass
+}
+
+func (*SyncMap) SingletonClass() *value.Class {
+	return nil
+}
+
+func (m *SyncMap) Inspect() string {
+	return fmt.Sprintf("Std::Sync::Map{&: %p, length: %d}", m, m.Length())
+}
+
+func (m *SyncMap) Error() string {
+	return m.Inspect()
+}
+
+func (*SyncMap) InstanceVariables() *value.InstanceVariables {
+	return nil
+}
+
+// Returns the number of pairs in the map.
+func (m *SyncMap) Length() int {
+	var length int
+	for i := range m.shards {
+		shard := &m.shards[i]
+		shard.mu.RLock()
+		length += shard.m.Length()
+		shard.mu.RUnlock()
+	}
+	return length
+}
+
+// Returns the pairs present in the map at the time of the call.
+// Each shard is copied atomically but pairs of different shards
+// may be modified in between.
+func (m *SyncMap) Snapshot() *value.ArrayListOfValue {
+	list := value.NewArrayListOfValue(0)
+	for i := range m.shards {
+		shard := &m.shards[i]
+		shard.mu.RLock()
+		for pair := range shard.m.All() {
+			list.Append(pair.ToValue())
+		}
+		shard.mu.RUnlock()
+	}
+	return list
+}
+
+func (m *SyncMap) Iterate() iter.Seq2[value.Value, value.Value] {
+	return m.Snapshot().Iterate()
+}
+
+func (m *SyncMap) Iter() value.NativeIterator {
+	return m.Snapshot().Iter()
+}
+
+func (m *SyncMap) shard(vm *Thread, key value.Value) (*syncMapShard, value.Value) {
+	hash, err := Hash(vm, key)
+	if !err.IsUndefined() {
+		return nil, err
+	}
+	// use the high bits so that the shard does not
+	// correlate with the index in the shard's table
+	return &m.shards[(hash>>32)%syncMapShardCount], value.Undefined
+}
+
+// Returns the value stored under the key
+// or undefined when the key is not present.
+// The caller must hold the lock of the shard.
+func syncMapShardGet(vm *Thread, shard *syncMapShard, key value.Value) (value.Value, value.Value) {
+	if shard.m.Length() == 0 {
+		return value.Undefined, value.Undefined
+	}
+
+	index, err := HashMapOfValueIndex(vm, shard.m, key)
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+	if index == -1 || shard.m.Table[index].Key().IsUndefined() {
+		return value.Undefined, value.Undefined
+	}
+	return shard.m.Table[index].Value(), value.Undefined
+}
+
+// Get the value under the given key.
+// Returns (undefined, undefined) when the key is not present.
+func (m *SyncMap) Get(vm *Thread, key value.Value) (value.Value, value.Value) {
+	shard, err := m.shard(vm, key)
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return syncMapShardGet(vm, shard, key)
+}
+
+// Set a value under the given key.
+func (m *SyncMap) Set(vm *Thread, key, val value.Value) value.Value {
+	shard, err := m.shard(vm, key)
+	if !err.IsUndefined() {
+		return err
+	}
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	return HashMapOfValueSet(vm, shard.m, key, val)
+}
+
+// Delete the given key and return its previous value.
+// Returns (undefined, undefined) when the key was not present.
+func (m *SyncMap) Remove(vm *Thread, key value.Value) (value.Value, value.Value) {
+	shard, err := m.shard(vm, key)
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	prev, err := syncMapShardGet(vm, shard, key)
+	if !err.IsUndefined() || prev.IsUndefined() {
+		return value.Undefined, err
+	}
+	if _, err := HashMapOfValueDelete(vm, shard.m, key); !err.IsUndefined() {
+		return value.Undefined, err
+	}
+	return prev, value.Undefined
+}
+
+// Return the value under the given key.
+// When the key is not present `fn` gets called
+// and its result is inserted under the key.
+//
+// `fn` is called without holding the lock.
+// Other callers with the same key wait for it to return,
+// so it gets called only once.
+func (m *SyncMap) GetOrInsert(vm *Thread, key, fn value.Value) (value.Value, value.Value) {
+	shard, err := m.shard(vm, key)
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+
+	for {
+		val, insertion, started, err := syncMapShardStartInsertion(vm, shard, key)
+		if !err.IsUndefined() {
+			return value.Undefined, err
+		}
+		if !val.IsUndefined() {
+			return val, value.Undefined
+		}
+		if started {
+			return syncMapShardFinishInsertion(vm, shard, insertion, fn)
+		}
+		if insertion.thread == vm {
+			return value.Undefined, value.Ref(value.Errorf(
+				value.ArgumentErrorClass,
+				"cannot call get_or_insert with key %s in its own function",
+				key.Inspect(),
+			))
+		}
+
+		select {
+		case <-insertion.done:
+		case <-vm.Aborter.Context().Done():
+			return value.Undefined, value.ExecutionAbortedError.ToValue()
+		}
+		if !insertion.result.IsUndefined() {
+			return insertion.result, value.Undefined
+		}
+		// the function of the other caller has failed, try again
+	}
+}
+
+// Returns the value under the key when it is present.
+// Otherwise returns the pending insertion of the key,
+// registering a new one when there is none.
+// `started` reports whether the insertion has been registered by this call.
+func syncMapShardStartInsertion(vm *Thread, shard *syncMapShard, key value.Value) (val value.Value, insertion *syncMapInsertion, started bool, err value.Value) {
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	val, err = syncMapShardGet(vm, shard, key)
+	if !err.IsUndefined() || !val.IsUndefined() {
+		return val, nil, false, err
+	}
+
+	for _, insertion := range shard.insertions {
+		equal, err := Equal(vm, insertion.key, key)
+		if !err.IsUndefined() {
+			return value.Undefined, nil, false, err
+		}
+		if value.Truthy(equal) {
+			return value.Undefined, insertion, false, value.Undefined
+		}
+	}
+
+	insertion = &syncMapInsertion{
+		key:    key,
+		thread: vm,
+		done:   make(chan struct{}),
+	}
+	shard.insertions = append(shard.insertions, insertion)
+	return value.Undefined, insertion, true, value.Undefined
+}
+
+// Call the function of the insertion and store its result
+// unless the key has been set in the meantime.
+// Wakes up the callers waiting for the insertion.
+func syncMapShardFinishInsertion(vm *Thread, shard *syncMapShard, insertion *syncMapInsertion, fn value.Value) (value.Value, value.Value) {
+	defer close(insertion.done)
+
+	result, err := vm.CallCallable(fn, insertion.key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	shard.insertions = slices.DeleteFunc(shard.insertions, func(i *syncMapInsertion) bool {
+		return i == insertion
+	})
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+
+	val, err := syncMapShardGet(vm, shard, insertion.key)
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+	if val.IsUndefined() {
+		if err := HashMapOfValueSet(vm, shard.m, insertion.key, result); !err.IsUndefined() {
+			return value.Undefined, err
+		}
+		val = result
+	}
+	insertion.result = val
+	return val, value.Undefined
+}
+
+// Replace the value under the given key with the result of `fn`.
+// `fn` receives the current value or nil when the key is not present.
+// When `fn` returns nil the key gets removed.
+//
+// `fn` is called without holding the lock.
+// When another thread modifies the key in the meantime
+// `fn` gets called again with the new value.
+func (m *SyncMap) Compute(vm *Thread, key, fn value.Value) (value.Value, value.Value) {
+	shard, err := m.shard(vm, key)
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+
+	shard.mu.RLock()
+	val, err := syncMapShardGet(vm, shard, key)
+	shard.mu.RUnlock()
+	if !err.IsUndefined() {
+		return value.Undefined, err
+	}
+
+	for {
+		arg := val
+		if arg.IsUndefined() {
+			arg = value.Nil
+		}
+		result, err := vm.CallCallable(fn, arg)
+		if !err.IsUndefined() {
+			return value.Undefined, err
+		}
+
+		current, swapped, err := syncMapShardSwap(vm, shard, key, val, result)
+		if !err.IsUndefined() {
+			return value.Undefined, err
+		}
+		if swapped {
+			return result, value.Undefined
+		}
+		val = current
+	}
+}
+
+// Replace the value under the given key with `new`
+// when the key still holds `old`.
+// Undefined `old` means that the key is not present,
+// nil `new` removes the key.
+//
+// Returns false and the current value (undefined when not present)
+// when the key has been modified.
+func syncMapShardSwap(vm *Thread, shard *syncMapShard, key, old, new value.Value) (value.Value, bool, value.Value) {
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	val, err := syncMapShardGet(vm, shard, key)
+	if !err.IsUndefined() {
+		return value.Undefined, false, err
+	}
+	if !syncMapSameValue(val, old) {
+		return val, false, value.Undefined
+	}
+
+	if new.IsNil() {
+		if !val.IsUndefined() {
+			if _, err := HashMapOfValueDelete(vm, shard.m, key); !err.IsUndefined() {
+				return value.Undefined, false, err
+			}
+		}
+		return value.Undefined, true, value.Undefined
+	}
+
+	if err := HashMapOfValueSet(vm, shard.m, key, new); !err.IsUndefined() {
+		return value.Undefined, false, err
+	}
+	return value.Undefined, true, value.Undefined
+}
+
+func syncMapSameValue(a, b value.Value) bool {
+	if a.IsUndefined() || b.IsUndefined() {
+		return a.IsUndefined() == b.IsUndefined()
+	}
+	return value.StrictEqual(a, b)
+}
+
+// Remove all pairs for which `fn` returns a truthy value.
+// Returns the number of removed pairs.
+//
+// `fn` is called without holding the lock
+// on a snapshot of each shard.
+// Pairs modified in the meantime are not removed.
+func (m *SyncMap) RemoveIf(vm *Thread, fn value.Value) (int, value.Value) {
+	var removed int
+	for i := range m.shards {
+		shard := &m.shards[i]
+		n, err := syncMapShardRemoveIf(vm, shard, fn)
+		removed += n
+		if !err.IsUndefined() {
+			return removed, err
+		}
+	}
+	return removed, value.Undefined
+}
+
+func syncMapShardRemoveIf(vm *Thread, shard *syncMapShard, fn value.Value) (int, value.Value) {
+	shard.mu.RLock()
+	pairs := make([]value.PairOfValue, 0, shard.m.Length())
+	for pair := range shard.m.All() {
+		pairs = append(pairs, pair)
+	}
+	shard.mu.RUnlock()
+
+	var matching []value.PairOfValue
+	for _, pair := range pairs {
+		result, err := vm.CallCallable(fn, pair.Key(), pair.Value())
+		if !err.IsUndefined() {
+			return 0, err
+		}
+		if value.Truthy(result) {
+			matching = append(matching, pair)
+		}
+	}
+	if len(matching) == 0 {
+		return 0, value.Undefined
+	}
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	var removed int
+	for _, pair := range matching {
+		val, err := syncMapShardGet(vm, shard, pair.Key())
+		if !err.IsUndefined() {
+			return removed, err
+		}
+		if !syncMapSameValue(val, pair.Value()) {
+			continue
+		}
+		if _, err := HashMapOfValueDelete(vm, shard.m, pair.Key()); !err.IsUndefined() {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, value.Undefined
+}
+
+// Std::Sync::Map
+func initSyncMap() {
+	// Instance methods
+	c := &value.SyncMapClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(NewSyncMap()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"[]",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			val, err := self.Get(vm, args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			if val.IsUndefined() {
+				return value.Nil, value.Undefined
+			}
+			return val, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"[]=",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			if err := self.Set(vm, args[1], args[2]); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"contains_key",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			val, err := self.Get(vm, args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.BoolVal(!val.IsUndefined()), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"remove",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			prev, err := self.Remove(vm, args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			if prev.IsUndefined() {
+				return value.Nil, value.Undefined
+			}
+			return prev, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"get_or_insert",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			return self.GetOrInsert(vm, args[1], args[2])
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"compute",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			return self.Compute(vm, args[1], args[2])
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"remove_if",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			removed, err := self.RemoveIf(vm, args[1])
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.SmallInt(removed).ToValue(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"length",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			return value.SmallInt(self.Length()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"iter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*SyncMap)(args[0].Pointer())
+			return self.Iter().ToValue(), value.Undefined
+		},
+	)
+}
@@ -0,0 +1,77 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// Std::Sync::Queue
+func initSyncQueue() {
+	// Instance methods
+	c := &value.SyncQueueClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			return value.Ref(value.NewSyncQueue()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"<<",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.SyncQueue)(args[0].Pointer())
+			self.Push(args[1])
+			return args[0], value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Alias(c, "push", "<<")
+	Def(
+		c,
+		"try_pop",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.SyncQueue)(args[0].Pointer())
+			val, ok := self.TryPop()
+			if !ok {
+				return value.Nil, value.Undefined
+			}
+			return val, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"pop",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.SyncQueue)(args[0].Pointer())
+			ctx, cancel := vm.abortContext(args[1])
+			defer cancel()
+
+			return self.Pop(ctx)
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"length",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.SyncQueue)(args[0].Pointer())
+			return value.SmallInt(self.Length()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_empty",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.SyncQueue)(args[0].Pointer())
+			return value.BoolVal(self.IsEmpty()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"iter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.SyncQueue)(args[0].Pointer())
+			return self.Iter().ToValue(), value.Undefined
+		},
+	)
+}
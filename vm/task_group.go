@@ -0,0 +1,297 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/value/symbol"
+)
+
+// ::Std::Sync::TaskGroup
+//
+// A scope of child promises and threads.
+// The first failure of a child cancels its siblings.
+type TaskGroup struct {
+	aborter     *value.Aborter // shared by all children, gets closed on the first failure
+	wg          sync.WaitGroup
+	m           sync.Mutex
+	errors      []value.Value
+	stackTraces []*value.StackTrace
+}
+
+// Create a new task group.
+// The group gets cancelled when the parent aborter is closed.
+func NewTaskGroup(parent *value.Aborter) *TaskGroup {
+	return &TaskGroup{
+		aborter: value.NewCancelAborter(parent),
+	}
+}
+
+func (*TaskGroup) Class() *value.Class {
+	return value.TaskGroupClass
+}
+
+func (*TaskGroup) DirectClass() *value.Class {
+	return value.TaskGroupClass
+}
+
+func (*TaskGroup) SingletonClass() *value.Class {
+	return nil
+}
+
+func (g *TaskGroup) Copy() value.Reference {
+	return g
+}
+
+func (g *TaskGroup) ToValue() value.Value {
+	return value.Ref(g)
+}
+
+func (g *TaskGroup) Inspect() string {
+	return fmt.Sprintf("Std::Sync::TaskGroup{&: %p}", g)
+}
+
+func (g *TaskGroup) Error() string {
+	return g.Inspect()
+}
+
+func (*TaskGroup) InstanceVariables() *value.InstanceVariables {
+	return nil
+}
+
+// Returns the aborter shared by all children of the group.
+func (g *TaskGroup) Aborter() *value.Aborter {
+	return g.aborter
+}
+
+// Cancel all children of the group.
+func (g *TaskGroup) Cancel() {
+	g.aborter.Close()
+}
+
+// Whether the group has been cancelled.
+func (g *TaskGroup) IsCancelled() bool {
+	return value.ShouldAbort(g.aborter)
+}
+
+// Record the failure of a child and cancel its siblings.
+// `execution aborted` errors of children
+// that have been cancelled by the group are ignored.
+func (g *TaskGroup) fail(err value.Value, stackTrace *value.StackTrace) {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	if g.IsCancelled() && err.Class() == value.ExecutionAbortedErrorClass {
+		return
+	}
+	g.errors = append(g.errors, err)
+	g.stackTraces = append(g.stackTraces, stackTrace)
+	g.Cancel()
+}
+
+// Add an existing promise to the group.
+// The promise gets cancelled when the group is cancelled.
+func (g *TaskGroup) AddPromise(promise *Promise) {
+	g.wg.Add(1)
+	stop := context.AfterFunc(g.aborter.Context(), promise.Cancel)
+	go func() {
+		_, stackTrace, err := promise.AwaitSync()
+		stop()
+		if !err.IsUndefined() {
+			g.fail(err, stackTrace)
+		}
+		g.wg.Done()
+	}()
+}
+
+// Add an existing thread started with `go` to the group.
+// The thread gets cancelled when the group is cancelled.
+func (g *TaskGroup) AddThread(thread *Thread) {
+	g.wg.Add(1)
+	stop := context.AfterFunc(g.aborter.Context(), func() {
+		thread.Aborter.Close()
+	})
+	go func() {
+		<-thread.done
+		stop()
+		if err, stackTrace := thread.UncaughtError(); !err.IsUndefined() {
+			g.fail(err, stackTrace)
+		}
+		g.wg.Done()
+	}()
+}
+
+// Execute the given function in the thread pool as a child of the group.
+func (g *TaskGroup) Spawn(threadPool *ThreadPool, fn value.Value) *Promise {
+	promise := NewNativePromiseWithAborter(
+		threadPool,
+		value.NewCancelAborter(g.aborter),
+		func(thread *Thread, _ []value.Value) (value.Value, value.Value) {
+			return thread.CallCallable(fn)
+		},
+	)
+	g.AddPromise(promise)
+	return promise
+}
+
+// Execute the given function in a new thread as a child of the group.
+func (g *TaskGroup) Go(vm *Thread, fn value.Value) *Thread {
+	thread := vm.goCallable(fn, value.NewCancelAborter(g.aborter))
+	g.AddThread(thread)
+	return thread
+}
+
+// Block until all children are done.
+// Returns a `Std::Sync::TaskGroup::Error` when any child has failed.
+func (g *TaskGroup) Wait() value.Value {
+	g.wg.Wait()
+
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	if len(g.errors) == 0 {
+		return value.Undefined
+	}
+
+	stackTraces := make([]value.Value, len(g.stackTraces))
+	for i, stackTrace := range g.stackTraces {
+		if stackTrace == nil {
+			stackTraces[i] = value.Nil
+			continue
+		}
+		stackTraces[i] = value.Ref(stackTrace)
+	}
+
+	return value.Ref(value.NewObject(
+		value.ObjectWithClass(value.TaskGroupErrorClass),
+		value.ObjectWithInstanceVariablesByName(value.SymbolMap{
+			symbol.L_message:      value.String(fmt.Sprintf("%d task(s) failed, first error: %s", len(g.errors), g.errors[0].Inspect())).ToValue(),
+			symbol.L_errors:       value.Ref(value.NewArrayListOfValueWithElements(0, g.errors...)),
+			symbol.L_stack_traces: value.Ref(value.NewArrayListOfValueWithElements(0, stackTraces...)),
+		}),
+	))
+}
+
+// ::Std::Sync::TaskGroup
+func initTaskGroup() {
+	// Singleton methods
+	c := &value.TaskGroupClass.SingletonClass().MethodContainer
+	Def(
+		c,
+		"run",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			group := NewTaskGroup(vm.Aborter)
+			result, err := vm.CallCallable(args[1], value.Ref(group))
+			if !err.IsUndefined() {
+				group.Cancel()
+				group.Wait()
+				return value.Undefined, err
+			}
+
+			err = group.Wait()
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return result, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+
+	// Instance methods
+	c = &value.TaskGroupClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			parent := vm.Aborter
+			if !args[1].IsUndefined() && !args[1].IsNil() {
+				parent = args[1].AsReference().(*value.Aborter)
+			}
+			return value.Ref(NewTaskGroup(parent)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"aborter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*TaskGroup)(args[0].Pointer())
+			return value.Ref(self.Aborter()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"spawn",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*TaskGroup)(args[0].Pointer())
+			return value.Ref(self.Spawn(vm.threadPool, args[1])), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"spawn_thread",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*TaskGroup)(args[0].Pointer())
+			return value.Ref(self.Go(vm, args[1])), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"add",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*TaskGroup)(args[0].Pointer())
+			switch child := args[1].AsReference().(type) {
+			case *Promise:
+				self.AddPromise(child)
+			case *Thread:
+				if !child.IsJoinable() {
+					return value.Undefined, value.Ref(value.NewError(
+						value.ArgumentErrorClass,
+						"only threads started with `go` can be added to a task group",
+					))
+				}
+				self.AddThread(child)
+			}
+			return args[0], value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Alias(c, "<<", "add")
+	Def(
+		c,
+		"cancel",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*TaskGroup)(args[0].Pointer())
+			self.Cancel()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_cancelled",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*TaskGroup)(args[0].Pointer())
+			return value.BoolVal(self.IsCancelled()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wait",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*TaskGroup)(args[0].Pointer())
+			if err := self.Wait(); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+	)
+
+	// ::Std::Sync::TaskGroup::Error
+	c = &value.TaskGroupErrorClass.MethodContainer
+	DefineGetter(c, symbol.L_errors, 1)
+	DefineGetter(c, symbol.L_stack_traces, 2)
+}
@@ -7,6 +7,8 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/elk-language/elk/bitfield"
@@ -38,6 +40,23 @@ type Thread struct {
 	threadPool      *ThreadPool
 	Aborter         *value.Aborter
 	state           state
+
+	instructionCount          uint64            // number of instructions executed by the thread, counted only when `COUNT_INSTRUCTIONS` is true
+	publishedInstructionCount atomic.Uint64     // `instructionCount` published for other threads at safe points
+	wallTime                  atomic.Int64      // total wall-clock time in nanoseconds spent by the thread executing code
+	safePointCountdown        int               // number of loop iterations left until the next safe point check
+	preemptAt                 time.Time         // the moment the time slice of the current promise runs out, zero when it cannot be preempted
+	promiseFrame              uintptr           // call frame pointer of the currently executed promise
+	promiseBody               *BytecodeFunction // bytecode of the currently executed promise
+
+	name   atomic.Pointer[string] // may be read and written by different threads
+	done   chan struct{}          // closed when a thread started with `go` finishes, nil for other threads
+	result value.Value            // the value returned by a finished thread started with `go`
+
+	debug threadDebugInfo // used for detecting deadlocks and leaks in debug builds
+
+	reloadedMethods *[]methodDefinition // collects method definitions instead of applying them when not nil
+	stdinBuffer     *stdinBuffer        // buffered reader of a custom Stdin, nil when Stdin is the standard input of the process
 }
 
 // Create a new VM instance.
@@ -53,15 +72,16 @@ func New(opts ...Option) *Thread {
 	id := currentID.Add(1)
 
 	vm := &Thread{
-		ID:         id,
-		stack:      stack,
-		sp:         uintptr(unsafe.Pointer(&stack[0])),
-		fp:         uintptr(unsafe.Pointer(&stack[0])),
-		callFrames: callFrames,
-		Stdin:      os.Stdin,
-		Stdout:     os.Stdout,
-		Stderr:     os.Stderr,
-		threadPool: DefaultThreadPool,
+		ID:                 id,
+		stack:              stack,
+		sp:                 uintptr(unsafe.Pointer(&stack[0])),
+		fp:                 uintptr(unsafe.Pointer(&stack[0])),
+		callFrames:         callFrames,
+		Stdin:              os.Stdin,
+		Stdout:             os.Stdout,
+		Stderr:             os.Stderr,
+		threadPool:         DefaultThreadPool,
+		safePointCountdown: SAFE_POINT_CHECK_INTERVAL,
 	}
 	vm.cfpSet(&callFrames[0])
 
@@ -130,8 +150,10 @@ func (vm *Thread) InterpretBreakpoint(fn *BytecodeFunction) (value.Value, value.
 }
 
 func (vm *Thread) runWithState() {
+	start := time.Now()
 	vm.state = runningState
 	vm.run()
+	vm.addWallTime(start)
 	if vm.state != errorState {
 		vm.state = terminatedState
 	}
@@ -198,11 +220,24 @@ func (vm *Thread) throwIfErr(err value.Value) {
 	}
 }
 
-func (vm *Thread) callBytecodePromise(promise *Promise) {
+func (vm *Thread) callBytecodePromise(promise *Promise, generator *Generator) {
 	vm.state = runningState
 	vm.createCurrentCallFrame(true)
 
-	generator := promise.Body.(*Generator)
+	if promise.Aborter != nil {
+		prevAborter := vm.Aborter
+		vm.Aborter = promise.Aborter
+		defer func() {
+			vm.Aborter = prevAborter
+		}()
+	}
+
+	vm.promiseFrame = vm.cfp
+	vm.promiseBody = generator.Bytecode
+	if timeSlice := vm.threadPool.TimeSlice(); timeSlice > 0 {
+		vm.preemptAt = time.Now().Add(timeSlice)
+	}
+
 	vm.bytecode = generator.Bytecode
 	vm.fp = vm.sp
 	vm.ip = generator.ip
@@ -218,8 +253,12 @@ func (vm *Thread) callBytecodePromise(promise *Promise) {
 
 	vm.run()
 
+	vm.preemptAt = time.Time{}
+	vm.promiseFrame = 0
+	vm.promiseBody = nil
+
 	switch vm.state {
-	case awaitState:
+	case awaitState, preemptedState:
 		stack := vm.stack[vm.fpOffset():vm.spOffset()]
 		stackCopy := make([]value.Value, len(stack))
 		copy(stackCopy, stack)
@@ -340,6 +379,13 @@ func (vm *Thread) CallBytecodeClosure(closure *BytecodeClosure, args ...value.Va
 			closure.Inspect(),
 		))
 	}
+
+	return vm.callBytecodeClosureUnchecked(closure, args...)
+}
+
+// Call a bytecode closure without checking whether
+// it has been created by another thread.
+func (vm *Thread) callBytecodeClosureUnchecked(closure *BytecodeClosure, args ...value.Value) (value.Value, value.Value) {
 	if closure.ParameterCount() != len(args) {
 		return value.Undefined, value.Ref(value.NewWrongArgumentCountError(
 			closure.Bytecode.Name().String(),
@@ -485,9 +531,99 @@ func (vm *Thread) callMethodOnStackByName(name value.Symbol, args int) value.Val
 	return vm.callMethodOnStack(method, args)
 }
 
+// Executed periodically at safe points (loop iterations).
+// Throws an error when the thread has been cancelled and
+// checks whether the current promise should be preempted.
+// Returns true when the VM should stop.
+func (vm *Thread) checkSafePoint() bool {
+	vm.safePointCountdown = SAFE_POINT_CHECK_INTERVAL
+	vm.publishInstructionCount()
+	if value.ShouldAbort(vm.Aborter) {
+		vm.throw(value.ExecutionAbortedError.ToValue())
+		return false
+	}
+
+	return vm.preempt()
+}
+
+// Check whether the currently executed promise
+// should give up its thread worker so that other tasks
+// waiting in the queue of the thread pool can run.
+// Returns true when the VM has been preempted and should stop.
+//
+// Promises can only be suspended at safe points in their own call frame,
+// like in the case of `await`, so loops in called functions
+// never preempt the promise.
+func (vm *Thread) preempt() bool {
+	if vm.preemptAt.IsZero() || vm.cfp != vm.promiseFrame || vm.bytecode != vm.promiseBody {
+		return false
+	}
+
+	now := time.Now()
+	if now.Before(vm.preemptAt) {
+		return false
+	}
+
+	if len(vm.threadPool.TaskQueue) == 0 {
+		// no other tasks are waiting, start a new time slice
+		vm.preemptAt = now.Add(vm.threadPool.TimeSlice())
+		return false
+	}
+
+	vm.state = preemptedState
+	return true
+}
+
+// Add the time elapsed since `start` to the wall time of the thread.
+func (vm *Thread) addWallTime(start time.Time) {
+	vm.wallTime.Add(int64(time.Since(start)))
+}
+
+// Make the number of executed instructions visible to other threads.
+func (vm *Thread) publishInstructionCount() {
+	if COUNT_INSTRUCTIONS {
+		vm.publishedInstructionCount.Store(vm.instructionCount)
+	}
+}
+
+// Returns the type information (`GlobalEnv`) of the program
+// that contains the currently executed bytecode function
+// or the nearest function in the call stack that has it.
+// Returns nil when no function has it.
+func (vm *Thread) CurrentGlobalEnv() any {
+	if vm.bytecode != nil && vm.bytecode.GlobalEnv != nil {
+		return vm.bytecode.GlobalEnv
+	}
+
+	callStack := vm.callStack()
+	for i := len(callStack) - 1; i >= 0; i-- {
+		fn := callStack[i].bytecode
+		if fn != nil && fn.GlobalEnv != nil {
+			return fn.GlobalEnv
+		}
+	}
+
+	return nil
+}
+
+// Returns the number of instructions executed by the thread.
+// The count gets updated at safe points and when the thread stops running.
+// Always returns zero when `COUNT_INSTRUCTIONS` is false.
+func (vm *Thread) InstructionCount() uint64 {
+	return vm.publishedInstructionCount.Load()
+}
+
+// Returns the total wall-clock time spent by the thread executing code.
+func (vm *Thread) WallTime() time.Duration {
+	return time.Duration(vm.wallTime.Load())
+}
+
 // The main execution loop of the VM.
 func (vm *Thread) run() {
+	vm.enterRun()
 	defer func() {
+		vm.exitRun()
+		vm.publishInstructionCount()
 		// Return normally if the panic was an elk error
 		r := recover()
 		if r == nil || r == (stopVM{}) {
@@ -497,6 +633,9 @@ func (vm *Thread) run() {
 	}()
 
 	for {
+		if COUNT_INSTRUCTIONS {
+			vm.instructionCount++
+		}
 		instruction := bytecode.OpCode(vm.readByte())
 		switch instruction {
 		case bytecode.STOP_ITERATION:
@@ -609,7 +748,9 @@ func (vm *Thread) run() {
 		case bytecode.AWAIT_SYNC:
 			promise := (*Promise)(vm.peek().Pointer())
 
+			vm.blockOnPromise(promise)
 			result, stackTrace, err := promise.AwaitSync()
+			vm.unblock()
 			if !err.IsUndefined() {
 				vm.pop()
 				vm.rethrow(err, vm.BuildStackTracePrepend(stackTrace))
@@ -1256,6 +1397,10 @@ func (vm *Thread) run() {
 		case bytecode.LOOP:
 			jump := vm.readUint16()
 			vm.ipDecrementBy(uintptr(jump))
+			vm.safePointCountdown--
+			if vm.safePointCountdown <= 0 && vm.checkSafePoint() {
+				return
+			}
 		case bytecode.THROW:
 			vm.throw(vm.popGet())
 		case bytecode.MUST:
@@ -1368,22 +1513,23 @@ func (vm *Thread) opGo() {
 
 // Create a new thread and run the given bytecode closure
 func (vm *Thread) GoBytecode(closure *BytecodeClosure) *Thread {
-	thread := New(
-		WithStdin(vm.Stdin),
-		WithStdout(vm.Stdout),
-		WithStderr(vm.Stderr),
-		WithAborter(value.NewCancelAborter(vm.Aborter)),
-	)
+	thread := vm.newGoThread()
 
 	go func(closure *BytecodeClosure, thread *Thread) {
+		thread.enterRun()
+		start := time.Now()
 		thread.state = runningState
 		thread.callGo(closure)
+		thread.addWallTime(start)
 		if thread.state != errorState {
+			thread.result = thread.peek()
 			thread.state = terminatedState
+			thread.finishGoThread()
 			return
 		}
 
-		thread.PrintError()
+		thread.handleUncaughtError()
+		thread.finishGoThread()
 	}(closure, thread)
 
 	return thread
@@ -1391,27 +1537,90 @@ func (vm *Thread) GoBytecode(closure *BytecodeClosure) *Thread {
 
 // Create a new thread and run the given native closure
 func (vm *Thread) GoNative(closure *NativeClosure) *Thread {
-	thread := New(
-		WithStdin(vm.Stdin),
-		WithStdout(vm.Stdout),
-		WithStderr(vm.Stderr),
-		WithAborter(value.NewCancelAborter(vm.Aborter)),
-	)
+	thread := vm.newGoThread()
 
 	go func(closure *NativeClosure, thread *Thread) {
+		thread.enterRun()
+		start := time.Now()
 		thread.state = runningState
-		closure.Function(thread, nil)
+		result, err := closure.Function(thread, nil)
+		thread.addWallTime(start)
+		if !err.IsUndefined() {
+			thread.push(err)
+			thread.state = errorState
+		}
 		if thread.state != errorState {
+			thread.result = result
 			thread.state = terminatedState
+			thread.finishGoThread()
 			return
 		}
 
-		thread.PrintError()
+		thread.handleUncaughtError()
+		thread.finishGoThread()
 	}(closure, thread)
 
 	return thread
 }
 
+// Create a new thread that calls the given callable value with the given aborter.
+// Uncaught errors are not handled,
+// they should be retrieved with `UncaughtError` after the thread is done.
+func (vm *Thread) goCallable(fn value.Value, aborter *value.Aborter) *Thread {
+	thread := vm.newGoThread()
+	thread.Aborter = aborter
+
+	go func(fn value.Value, thread *Thread) {
+		thread.enterRun()
+		start := time.Now()
+		thread.state = runningState
+		result, err := thread.CallCallable(fn)
+		thread.addWallTime(start)
+		if !err.IsUndefined() {
+			stackTrace := thread.errStackTrace
+			thread.push(err)
+			thread.state = errorState
+			thread.errStackTrace = stackTrace
+			thread.finishGoThread()
+			return
+		}
+
+		thread.result = result
+		thread.state = terminatedState
+		thread.finishGoThread()
+	}(fn, thread)
+
+	return thread
+}
+
+// Create a new joinable thread that will be started with `go`.
+func (vm *Thread) newGoThread() *Thread {
+	thread := New(
+		withStdinOf(vm),
+		WithStdout(vm.Stdout),
+		WithStderr(vm.Stderr),
+		WithAborter(value.NewCancelAborter(vm.Aborter)),
+	)
+	thread.done = make(chan struct{})
+	vm.trackGoThread(thread)
+	return thread
+}
+
+// Mark a thread started with `go` as finished.
+func (vm *Thread) finishGoThread() {
+	vm.exitRun()
+	untrackGoThread(vm)
+	close(vm.done)
+}
+
+// A thread started with `go` or a promise
+// that is still running when it should have finished.
+type Leak struct {
+	Seq         uint64            // creation order
+	Description string            // human readable description
+	StackTrace  *value.StackTrace // where the thread has been started, nil for promises
+}
+
 func (vm *Thread) opClosedClosure() {
 	function := vm.peek().AsReference().(*BytecodeFunction)
 	closure := NewBytecodeClosure(vm.ID, function, vm.selfValue())
@@ -1570,7 +1779,11 @@ func (vm *Thread) BuildStackTrace() *value.StackTrace {
 func (vm *Thread) BuildStackTracePrepend(base *value.StackTrace) *value.StackTrace {
 	callStack := vm.callStack()
 
-	stackTraceSlice := make([]value.CallFrame, 0, len(*base)+len(callStack)+1)
+	var baseLen int
+	if base != nil {
+		baseLen = len(*base)
+	}
+	stackTraceSlice := make([]value.CallFrame, 0, baseLen+len(callStack)+1)
 	for _, element := range callStack {
 		if !element.isNative && element.bytecode == nil {
 			continue
@@ -1580,7 +1793,9 @@ func (vm *Thread) BuildStackTracePrepend(base *value.StackTrace) *value.StackTra
 	if vm.bytecode != nil {
 		stackTraceSlice = append(stackTraceSlice, vm.makeCallFrameObject())
 	}
-	stackTraceSlice = append(stackTraceSlice, (*base)...)
+	if base != nil {
+		stackTraceSlice = append(stackTraceSlice, (*base)...)
+	}
 
 	return (*value.StackTrace)(&stackTraceSlice)
 }
@@ -1850,14 +2065,22 @@ func (vm *Thread) selfValue() value.Value {
 }
 
 func (vm *Thread) lookupMethod(class *value.Class, callInfo *CallSiteInfo, index int) value.Method {
-	for i := range len(callInfo.Cache) {
-		cacheEntry := callInfo.Cache[i]
+	cache := callInfo.Cache
+	var epoch uint64
+	if hotReloadEnabled {
+		epoch = callCacheEpoch.Load()
+		if callInfo.epoch != epoch {
+			cache = [3]CallCacheEntry{}
+		}
+	}
+	for i := range len(cache) {
+		cacheEntry := cache[i]
 		if cacheEntry.Class == class {
 			return cacheEntry.Method
 		}
 		if cacheEntry.Class == nil {
 			method := class.LookupMethod(callInfo.Name)
-			newCache := callInfo.Cache
+			newCache := cache
 			newCache[i] = CallCacheEntry{
 				Class:  class,
 				Method: method,
@@ -1866,6 +2089,7 @@ func (vm *Thread) lookupMethod(class *value.Class, callInfo *CallSiteInfo, index
 				Name:          callInfo.Name,
 				ArgumentCount: callInfo.ArgumentCount,
 				Cache:         newCache,
+				epoch:         epoch,
 			})
 			return method
 		}
@@ -1968,7 +2192,7 @@ func (vm *Thread) opPromise() {
 		threadPool = (*ThreadPool)(arg.Pointer())
 	}
 
-	promise := NewPromise(threadPool, generator)
+	promise := NewPromiseWithAborter(threadPool, generator, value.NewReleasableAborter(vm.Aborter))
 	vm.push(value.Ref(promise))
 }
 
@@ -2197,10 +2421,14 @@ func (vm *Thread) opCallMethod(callInfoIndex int) (err value.Value) {
 // Call a bytecode method pointer
 func (vm *Thread) opCallMethodBytecodePtr(callInfoIndex int) {
 	callInfo := (*BytecodeCallSiteInfo)(vm.bytecode.Values[callInfoIndex].Pointer())
+	method := callInfo.Method
+	if hotReloadEnabled {
+		method = method.current()
+	}
 	if callInfo.TailCall {
-		vm.callBytecodeFunctionTCO(callInfo.Method, callInfo.ArgumentCount)
+		vm.callBytecodeFunctionTCO(method, callInfo.ArgumentCount)
 	} else {
-		vm.callBytecodeFunction(callInfo.Method, callInfo.ArgumentCount)
+		vm.callBytecodeFunction(method, callInfo.ArgumentCount)
 	}
 }
 
@@ -2349,7 +2577,7 @@ func (vm *Thread) opDefMethod() {
 
 	switch m := methodContainer.SafeAsReference().(type) {
 	case *value.Class:
-		m.Methods[name] = body
+		vm.defineMethod(&m.MethodContainer, name, body)
 	default:
 		panic(fmt.Sprintf("invalid method container: %s", methodContainer.Inspect()))
 	}
@@ -2376,7 +2604,7 @@ func (vm *Thread) opDefGetter() {
 
 	switch m := methodContainer.SafeAsReference().(type) {
 	case *value.Class:
-		DefineGetter(&m.MethodContainer, name, int(index))
+		vm.defineMethod(&m.MethodContainer, name, NewGetterMethod(name, int(index)))
 	default:
 		panic(fmt.Sprintf("cannot define a getter in an invalid method container: %s", methodContainer.Inspect()))
 	}
@@ -2390,7 +2618,8 @@ func (vm *Thread) opDefSetter() {
 
 	switch m := methodContainer.SafeAsReference().(type) {
 	case *value.Class:
-		DefineSetter(&m.MethodContainer, name, int(index))
+		setter := NewSetterMethod(name, int(index))
+		vm.defineMethod(&m.MethodContainer, setter.name, setter)
 	default:
 		panic(fmt.Sprintf("cannot define a setter in an invalid method container: %s", methodContainer.Inspect()))
 	}
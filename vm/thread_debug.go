@@ -3,7 +3,14 @@
 package vm
 
 import (
+	"cmp"
 	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/elk-language/elk/value"
 )
@@ -88,3 +95,289 @@ func (vm *Thread) cfpDecrementBy(n uintptr) {
 	}
 	vm.cfp = vm.cfpSubtractRaw(n)
 }
+
+// How long all running threads have to stay blocked
+// before a deadlock gets reported.
+const deadlockTimeout = time.Second
+
+// How often the deadlock detector inspects blocked threads.
+const deadlockCheckInterval = 100 * time.Millisecond
+
+// Called with a description of the blocked threads
+// when a deadlock gets detected.
+// Prints the description and exits by default.
+var DeadlockHandler = func(report string) {
+	fmt.Fprint(os.Stderr, report)
+	os.Exit(1)
+}
+
+// Data used to detect deadlocks and leaks.
+type threadDebugInfo struct {
+	depth int // number of nested executions, owned by the thread
+
+	// guarded by the mutex of the registry
+	blockedOn  string            // operation the thread is blocked on, empty when the thread is not blocked
+	blockTrace *value.StackTrace // stack trace of the blocked thread
+	seq        uint64            // sequence number of a thread started with `go`
+	spawnTrace *value.StackTrace // where a thread started with `go` has been spawned
+}
+
+// Data used to detect leaked promises.
+type promiseDebugInfo struct {
+	seq      uint64
+	external bool // whether the promise gets resolved by native code
+}
+
+// Keeps track of running threads and unresolved promises.
+var debugRegistry struct {
+	m          sync.Mutex
+	running    map[*Thread]struct{} // threads that are currently executing code
+	goThreads  map[*Thread]struct{} // unfinished threads started with `go`
+	promises   map[*Promise]struct{}
+	external   int    // number of unresolved external promises
+	seq        uint64 // last assigned sequence number
+	generation uint64 // gets incremented on every change
+	watchdog   bool   // whether the deadlock detector has been started
+}
+
+func init() {
+	debugRegistry.running = make(map[*Thread]struct{})
+	debugRegistry.goThreads = make(map[*Thread]struct{})
+	debugRegistry.promises = make(map[*Promise]struct{})
+}
+
+// Register the start of an execution.
+// Only the outermost execution is taken into account.
+func (vm *Thread) enterRun() {
+	vm.debug.depth++
+	if vm.debug.depth != 1 {
+		return
+	}
+
+	debugRegistry.m.Lock()
+	debugRegistry.running[vm] = struct{}{}
+	debugRegistry.generation++
+	if !debugRegistry.watchdog {
+		debugRegistry.watchdog = true
+		go deadlockWatchdog()
+	}
+	debugRegistry.m.Unlock()
+}
+
+// Register the end of an execution.
+func (vm *Thread) exitRun() {
+	vm.debug.depth--
+	if vm.debug.depth != 0 {
+		return
+	}
+
+	debugRegistry.m.Lock()
+	delete(debugRegistry.running, vm)
+	debugRegistry.generation++
+	debugRegistry.m.Unlock()
+}
+
+// Mark the thread as blocked on the given operation.
+func (vm *Thread) block(operation string) {
+	stackTrace := vm.BuildStackTrace()
+
+	debugRegistry.m.Lock()
+	vm.debug.blockedOn = operation
+	vm.debug.blockTrace = stackTrace
+	debugRegistry.generation++
+	debugRegistry.m.Unlock()
+}
+
+// Mark the thread as no longer blocked.
+func (vm *Thread) unblock() {
+	debugRegistry.m.Lock()
+	if vm.debug.blockedOn != "" {
+		vm.debug.blockedOn = ""
+		vm.debug.blockTrace = nil
+		debugRegistry.generation++
+	}
+	debugRegistry.m.Unlock()
+}
+
+// Mark the thread as blocked on a channel operation.
+// Only regular channels are tracked, other channels
+// may be fed by timers and native code.
+// Operations that can time out are skipped.
+func (vm *Thread) blockOnChannel(ch value.Channel, operation string) {
+	if _, ok := ch.(*value.ChannelOfValue); !ok {
+		return
+	}
+	if _, ok := vm.Aborter.Context().Deadline(); ok {
+		return
+	}
+
+	vm.block(operation)
+}
+
+// Mark the thread as blocked on awaiting a promise.
+// Promises resolved by native code are skipped.
+func (vm *Thread) blockOnPromise(promise *Promise) {
+	if promise.debug.external {
+		return
+	}
+
+	vm.block("await")
+}
+
+// Register a new thread started with `go`.
+func (vm *Thread) trackGoThread(thread *Thread) {
+	stackTrace := vm.BuildStackTrace()
+
+	debugRegistry.m.Lock()
+	debugRegistry.seq++
+	thread.debug.seq = debugRegistry.seq
+	thread.debug.spawnTrace = stackTrace
+	debugRegistry.goThreads[thread] = struct{}{}
+	debugRegistry.generation++
+	debugRegistry.m.Unlock()
+}
+
+// Remove a finished thread started with `go` from the registry.
+func untrackGoThread(thread *Thread) {
+	debugRegistry.m.Lock()
+	delete(debugRegistry.goThreads, thread)
+	debugRegistry.generation++
+	debugRegistry.m.Unlock()
+}
+
+// Register a new unresolved promise.
+func trackPromise(promise *Promise, external bool) {
+	debugRegistry.m.Lock()
+	debugRegistry.seq++
+	promise.debug.seq = debugRegistry.seq
+	promise.debug.external = external
+	debugRegistry.promises[promise] = struct{}{}
+	if external {
+		debugRegistry.external++
+	}
+	debugRegistry.generation++
+	debugRegistry.m.Unlock()
+}
+
+// Remove a resolved promise from the registry.
+func untrackPromise(promise *Promise) {
+	debugRegistry.m.Lock()
+	if _, ok := debugRegistry.promises[promise]; ok {
+		delete(debugRegistry.promises, promise)
+		if promise.debug.external {
+			debugRegistry.external--
+		}
+		debugRegistry.generation++
+	}
+	debugRegistry.m.Unlock()
+}
+
+// Returns a mark that can be passed to `LeaksSince`.
+func LeakMark() uint64 {
+	debugRegistry.m.Lock()
+	defer debugRegistry.m.Unlock()
+
+	return debugRegistry.seq
+}
+
+// Returns threads started with `go` and promises
+// that have been created after the given mark
+// and are still running.
+func LeaksSince(mark uint64) []Leak {
+	debugRegistry.m.Lock()
+	defer debugRegistry.m.Unlock()
+
+	var leaks []Leak
+	for thread := range debugRegistry.goThreads {
+		if thread.debug.seq <= mark {
+			continue
+		}
+		leaks = append(leaks, Leak{
+			Seq:         thread.debug.seq,
+			Description: fmt.Sprintf("thread #%d started with `go` is still running", thread.ID),
+			StackTrace:  thread.debug.spawnTrace,
+		})
+	}
+	for promise := range debugRegistry.promises {
+		if promise.debug.seq <= mark {
+			continue
+		}
+		leaks = append(leaks, Leak{
+			Seq:         promise.debug.seq,
+			Description: fmt.Sprintf("promise %p is still unresolved", promise),
+		})
+	}
+	slices.SortFunc(leaks, func(a, b Leak) int {
+		return cmp.Compare(a.Seq, b.Seq)
+	})
+
+	return leaks
+}
+
+// Periodically checks whether all running threads are blocked.
+func deadlockWatchdog() {
+	var generation uint64
+	var blockedSince time.Time
+	var reported bool
+
+	ticker := time.NewTicker(deadlockCheckInterval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		debugRegistry.m.Lock()
+		if !isDeadlocked() {
+			blockedSince = time.Time{}
+			reported = false
+			debugRegistry.m.Unlock()
+			continue
+		}
+		if blockedSince.IsZero() || generation != debugRegistry.generation {
+			generation = debugRegistry.generation
+			blockedSince = now
+			reported = false
+			debugRegistry.m.Unlock()
+			continue
+		}
+		if reported || now.Sub(blockedSince) < deadlockTimeout {
+			debugRegistry.m.Unlock()
+			continue
+		}
+
+		report := deadlockReport()
+		reported = true
+		debugRegistry.m.Unlock()
+		DeadlockHandler(report)
+	}
+}
+
+// Whether all running threads are blocked
+// and no external promise can wake them up.
+// Should be called with the mutex of the registry locked.
+func isDeadlocked() bool {
+	if len(debugRegistry.running) == 0 || debugRegistry.external > 0 {
+		return false
+	}
+	for thread := range debugRegistry.running {
+		if thread.debug.blockedOn == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Describe the blocked threads.
+// Should be called with the mutex of the registry locked.
+func deadlockReport() string {
+	threads := slices.SortedFunc(maps.Keys(debugRegistry.running), func(a, b *Thread) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	var buffer strings.Builder
+	buffer.WriteString("fatal error: all threads are blocked - deadlock!\n")
+	for _, thread := range threads {
+		fmt.Fprintf(&buffer, "\nthread #%d is blocked on `%s`\n", thread.ID, thread.debug.blockedOn)
+		buffer.WriteString(thread.debug.blockTrace.String())
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,118 @@
+//go:build debug
+
+package vm_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elk-language/elk"
+	"github.com/elk-language/elk/types/checker"
+	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
+)
+
+// Compile the source and run it in a new thread
+// that gets aborted when the context is cancelled.
+func runDebugSource(ctx context.Context, source string, t *testing.T) (value.Value, value.Value) {
+	t.Helper()
+
+	elk.InitGlobalEnvironment()
+	typechecker := checker.New()
+	chunk, compileErr := typechecker.CheckSourceBytecode(testFileName, source)
+	if compileErr.IsFailure() {
+		t.Fatalf("Compile Error: %s", compileErr.Error())
+	}
+
+	aborter := value.NewAborter(ctx, nil)
+	tp := vm.NewThreadPool(2, 50, vm.WithAborter(aborter))
+	defer tp.Close()
+	v := vm.New(vm.WithThreadPool(tp), vm.WithAborter(aborter))
+	return v.InterpretTopLevel(chunk)
+}
+
+func TestDeadlockDetection(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	reports := make(chan string, 1)
+	prevHandler := vm.DeadlockHandler
+	vm.DeadlockHandler = func(report string) {
+		reports <- report
+		cancel()
+	}
+	defer func() {
+		vm.DeadlockHandler = prevHandler
+	}()
+
+	// the threads get aborted after the deadlock is reported
+	runDebugSource(ctx, `
+		ch := Channel::[Int]()
+		m := Sync::Mutex()
+		m.lock
+		go
+			m.lock
+		end
+		<<ch
+		m.unlock
+	`, t)
+
+	var report string
+	select {
+	case report = <-reports:
+	case <-time.After(time.Second):
+		t.Fatal("deadlock has not been reported")
+	}
+
+	wantParts := []string{
+		"fatal error: all threads are blocked - deadlock!",
+		"is blocked on `Std::Channel#<<@`",
+		"sourceName:8",
+		"is blocked on `Std::Sync::Mutex#lock`",
+		"sourceName:6",
+	}
+	for _, part := range wantParts {
+		if !strings.Contains(report, part) {
+			t.Errorf("report does not contain %q:\n%s", part, report)
+		}
+	}
+}
+
+func TestLeaksSince(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mark := vm.LeakMark()
+	_, err := runDebugSource(ctx, `
+		ch := Channel::[Int]()
+		go
+			<<ch
+		end
+	`, t)
+	if !err.IsUndefined() {
+		t.Fatalf("Runtime Error: %s", err.Inspect())
+	}
+
+	leaks := vm.LeaksSince(mark)
+	if len(leaks) != 1 {
+		t.Fatalf("expected 1 leak, got: %#v", leaks)
+	}
+	if !strings.Contains(leaks[0].Description, "started with `go` is still running") {
+		t.Errorf("invalid leak description: %s", leaks[0].Description)
+	}
+	if leaks[0].StackTrace == nil || !strings.Contains(leaks[0].StackTrace.String(), "sourceName:3") {
+		t.Errorf("invalid leak stack trace: %v", leaks[0].StackTrace)
+	}
+
+	// the thread finishes when it gets aborted
+	cancel()
+	deadline := time.Now().Add(time.Second)
+	for len(vm.LeaksSince(mark)) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("leaked thread has not finished: %#v", vm.LeaksSince(mark))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
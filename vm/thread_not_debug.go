@@ -38,3 +38,37 @@ func (vm *Thread) spIncrementBy(n uintptr) {
 func (vm *Thread) cfpDecrementBy(n uintptr) {
 	vm.cfp = vm.cfpSubtractRaw(n)
 }
+
+type threadDebugInfo struct{}
+
+type promiseDebugInfo struct{}
+
+func (vm *Thread) enterRun() {}
+
+func (vm *Thread) exitRun() {}
+
+func (vm *Thread) block(operation string) {}
+
+func (vm *Thread) unblock() {}
+
+func (vm *Thread) blockOnChannel(ch value.Channel, operation string) {}
+
+func (vm *Thread) blockOnPromise(promise *Promise) {}
+
+func (vm *Thread) trackGoThread(thread *Thread) {}
+
+func untrackGoThread(thread *Thread) {}
+
+func trackPromise(promise *Promise, external bool) {}
+
+func untrackPromise(promise *Promise) {}
+
+// Leaks are only tracked in debug builds.
+func LeakMark() uint64 {
+	return 0
+}
+
+// Leaks are only tracked in debug builds.
+func LeaksSince(mark uint64) []Leak {
+	return nil
+}
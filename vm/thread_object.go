@@ -2,10 +2,27 @@ package vm
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/elk-language/elk/value"
 )
 
+// Callable value executed when an error
+// has not been caught in a thread started with `go`.
+// Errors are printed to stderr when it's nil.
+var uncaughtErrorHandler atomic.Pointer[value.Value]
+
+// Set the handler of errors that have not been caught in threads started with `go`.
+// Nil restores the default handler that prints the error.
+func SetUncaughtErrorHandler(handler value.Value) {
+	if handler.IsNil() || handler.IsUndefined() {
+		uncaughtErrorHandler.Store(nil)
+		return
+	}
+	uncaughtErrorHandler.Store(&handler)
+}
+
 func (*Thread) Class() *value.Class {
 	return value.ThreadClass
 }
@@ -19,6 +36,9 @@ func (*Thread) SingletonClass() *value.Class {
 }
 
 func (vm *Thread) Inspect() string {
+	if name := vm.Name(); name != "" {
+		return fmt.Sprintf(`Std::Thread{name: %s, state: %s}`, value.String(name).Inspect(), stateSymbols[vm.state].Inspect())
+	}
 	return fmt.Sprintf(`Std::Thread{state: %s}`, stateSymbols[vm.state].Inspect())
 }
 
@@ -42,10 +62,130 @@ func (vm *Thread) StateSymbol() value.Symbol {
 	return stateSymbols[vm.state]
 }
 
+func (vm *Thread) Name() string {
+	name := vm.name.Load()
+	if name == nil {
+		return ""
+	}
+	return *name
+}
+
+func (vm *Thread) SetName(name string) {
+	vm.name.Store(&name)
+}
+
+// Whether the thread has been started with `go`
+// and can be joined.
+func (vm *Thread) IsJoinable() bool {
+	return vm.done != nil
+}
+
+// Whether the thread started with `go` has finished.
+func (vm *Thread) IsDone() bool {
+	if vm.done == nil {
+		return false
+	}
+
+	select {
+	case <-vm.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Wait for a thread started with `go` to finish.
+// A non-positive timeout means no timeout.
+// Returns false when the timeout has elapsed
+// or the given aborter has been closed before the thread finished.
+func (vm *Thread) Join(timeout time.Duration, aborter *value.Aborter) bool {
+	var timeoutChan <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutChan = timer.C
+	}
+
+	select {
+	case <-vm.done:
+		return true
+	case <-timeoutChan:
+		return false
+	case <-aborter.Context().Done():
+		return false
+	}
+}
+
+// Returns the value returned by a finished thread started with `go`.
+// Returns `Undefined` when the thread has not finished successfully.
+func (vm *Thread) Result() value.Value {
+	if !vm.IsDone() || vm.state == errorState {
+		return value.Undefined
+	}
+	return vm.result
+}
+
+// Returns the uncaught error and its stack trace
+// of a finished thread started with `go`.
+// Returns `Undefined` when the thread has not failed.
+func (vm *Thread) UncaughtError() (value.Value, *value.StackTrace) {
+	if !vm.IsDone() || vm.state != errorState {
+		return value.Undefined, nil
+	}
+	return vm.Err(), vm.errStackTrace
+}
+
+// Handle an error that has not been caught
+// in a thread started with `go`.
+func (vm *Thread) handleUncaughtError() {
+	handler := uncaughtErrorHandler.Load()
+	if handler == nil {
+		vm.PrintError()
+		return
+	}
+
+	handlerThread := New(
+		withStdinOf(vm),
+		WithStdout(vm.Stdout),
+		WithStderr(vm.Stderr),
+	)
+	_, err := handlerThread.CallCallable(*handler, vm.ToValue(), vm.Err())
+	if !err.IsUndefined() {
+		handlerThread.PrintErrorValue(err)
+	}
+}
+
 // Std::Thread
 func initThread() {
+	// Singleton methods
+	c := &value.ThreadClass.SingletonClass().MethodContainer
+	Def(
+		c,
+		"current",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			return vm.ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"on_uncaught_error",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			SetUncaughtErrorHandler(args[1])
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"clear_uncaught_error_handler",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			SetUncaughtErrorHandler(value.Nil)
+			return value.Nil, value.Undefined
+		},
+	)
+
 	// Instance methods
-	c := &value.ThreadClass.MethodContainer
+	c = &value.ThreadClass.MethodContainer
 
 	Def(
 		c,
@@ -63,6 +203,139 @@ func initThread() {
 			return self.StateSymbol().ToValue(), value.Undefined
 		},
 	)
+	Def(
+		c,
+		"name",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			name := self.Name()
+			if name == "" {
+				return value.Nil, value.Undefined
+			}
+			return value.Ref(value.String(name)), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"name=",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			self.SetName(args[1].AsReference().(value.String).String())
+			return args[1], value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"is_done",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			return value.BoolVal(self.IsDone()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"join",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			if !self.IsJoinable() {
+				return value.Undefined, value.Ref(value.NewError(
+					value.ThreadErrorClass,
+					"only threads started with `go` can be joined",
+				))
+			}
+			if self == vm {
+				return value.Undefined, value.Ref(value.NewError(
+					value.ThreadErrorClass,
+					"a thread cannot join itself",
+				))
+			}
+
+			var timeout time.Duration
+			if !args[1].IsUndefined() && !args[1].IsNil() {
+				timeout = args[1].AsTimeSpan().Native()
+			}
+
+			if !self.Join(timeout, vm.Aborter) {
+				if value.ShouldAbort(vm.Aborter) {
+					return value.Undefined, value.ExecutionAbortedError.ToValue()
+				}
+				return value.Undefined, value.Ref(value.NewError(
+					value.ThreadTimeoutErrorClass,
+					fmt.Sprintf("thread has not finished in %s", value.TimeSpan(timeout).Inspect()),
+				))
+			}
+
+			if err, _ := self.UncaughtError(); !err.IsUndefined() {
+				return value.Undefined, value.Ref(value.NewError(
+					value.ThreadErrorClass,
+					fmt.Sprintf("thread terminated with an uncaught error: %s", err.Inspect()),
+				))
+			}
+			return self.Result(), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"error",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			err, _ := self.UncaughtError()
+			if err.IsUndefined() {
+				return value.Nil, value.Undefined
+			}
+			return err, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stack_trace",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			_, stackTrace := self.UncaughtError()
+			if stackTrace == nil {
+				return value.Nil, value.Undefined
+			}
+			return value.Ref(stackTrace), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"aborter",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			return value.Ref(self.Aborter), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"cancel",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			err := self.Aborter.Close()
+			if !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"instruction_count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			return value.ToElkInt(int64(self.InstructionCount())), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"wall_time",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*Thread)(args[0].Pointer())
+			return value.TimeSpan(self.WallTime()).ToValue(), value.Undefined
+		},
+	)
 	Def(
 		c,
 		"inspect",
@@ -2,6 +2,10 @@ package vm
 
 import (
 	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/elk-language/elk/value"
 )
@@ -9,6 +13,14 @@ import (
 type ThreadPool struct {
 	Threads   []*Thread
 	TaskQueue chan *Promise
+	timeSlice atomic.Int64  // maximum time a promise can occupy a thread worker while other tasks are waiting
+	running   atomic.Int64  // number of tasks that are currently being executed by thread workers
+	completed atomic.Uint64 // number of tasks that have been resolved or rejected
+
+	m       sync.Mutex
+	idle    *sync.Cond // gets broadcast when all pending tasks have been completed
+	pending int        // number of tasks that have been added to the pool and have not been completed yet
+	closed  bool
 }
 
 func NewThreadPool(threadCount, queueSize int, opts ...Option) *ThreadPool {
@@ -25,34 +37,85 @@ func (tp *ThreadPool) Call(fn func(vm *Thread) (result value.Value, err value.Va
 
 func (tp *ThreadPool) initThreadPool(threadCount, queueSize int, opts ...Option) {
 	tp.TaskQueue = make(chan *Promise, queueSize)
+	tp.idle = sync.NewCond(&tp.m)
+	tp.SetTimeSlice(DEFAULT_TIME_SLICE)
 
 	threads := make([]*Thread, threadCount)
 	for i := range threads {
 		opts = append(opts, WithThreadPool(tp))
 		thread := New(opts...)
 		threads[i] = thread
-		go threadWorker(thread, tp.TaskQueue)
+		go threadWorker(thread, tp)
 	}
 	tp.Threads = threads
 }
 
-func threadWorker(thread *Thread, queue chan *Promise) {
-	for task := range queue {
-		switch body := task.Body.(type) {
+func threadWorker(thread *Thread, tp *ThreadPool) {
+	for task := range tp.TaskQueue {
+		thread.enterRun()
+		start := time.Now()
+		tp.running.Add(1)
+		var completed bool
+		switch body := task.body().(type) {
+		case nil:
+			// the promise has been cancelled
+			completed = true
 		case *Generator:
-			executeBytecodePromise(thread, queue, task)
+			completed = executeBytecodePromise(thread, tp.TaskQueue, task, body)
 		case *NativePromiseBody:
-			executeNativePromise(thread, queue, task, body)
+			completed = executeNativePromise(thread, tp.TaskQueue, task, body)
 		default:
 			panic(fmt.Sprintf("invalid promise body: %T", task.Body))
 		}
+		tp.running.Add(-1)
+		if completed {
+			tp.completeTask()
+		}
 
+		thread.addWallTime(start)
 		thread.state = idleState
+		thread.exitRun()
 	}
 }
 
-func executeBytecodePromise(thread *Thread, queue chan *Promise, task *Promise) {
-	thread.callBytecodePromise(task)
+// Mark a task as completed.
+// Closes the task queue when the pool has been closed
+// and there are no more pending tasks.
+func (tp *ThreadPool) completeTask() {
+	tp.completed.Add(1)
+
+	tp.m.Lock()
+	tp.pending--
+	if tp.pending == 0 {
+		tp.idle.Broadcast()
+		if tp.closed {
+			close(tp.TaskQueue)
+		}
+	}
+	tp.m.Unlock()
+}
+
+// Execute a bytecode promise until it finishes or suspends.
+// Reports whether the promise has been resolved or rejected.
+func executeBytecodePromise(thread *Thread, queue chan *Promise, task *Promise, generator *Generator) bool {
+	if task.Aborter != nil && value.ShouldAbort(task.Aborter) {
+		task.Reject(value.ExecutionAbortedError.ToValue(), nil)
+		return true
+	}
+
+	thread.callBytecodePromise(task, generator)
+	for thread.state == preemptedState {
+		// put the promise at the end of the queue
+		// so that other tasks can run
+		select {
+		case queue <- task:
+			return false
+		default:
+			// the queue is full, keep running the promise
+			// instead of blocking the worker
+			thread.callBytecodePromise(task, generator)
+		}
+	}
 
 	switch thread.state {
 	case awaitState:
@@ -61,6 +124,7 @@ func executeBytecodePromise(thread *Thread, queue chan *Promise, task *Promise)
 
 		// promise has been locked in the VM
 		awaitedPromise.m.Unlock()
+		return false
 	case errorState:
 		err := thread.popGet()
 		stackTrace := thread.GetStackTrace()
@@ -69,16 +133,36 @@ func executeBytecodePromise(thread *Thread, queue chan *Promise, task *Promise)
 		result := thread.popGet()
 		task.Resolve(result)
 	}
+
+	return true
 }
 
-func executeNativePromise(thread *Thread, queue chan *Promise, task *Promise, body *NativePromiseBody) {
+func executeNativePromise(thread *Thread, queue chan *Promise, task *Promise, body *NativePromiseBody) bool {
+	if task.Aborter != nil {
+		if value.ShouldAbort(task.Aborter) {
+			task.Reject(value.ExecutionAbortedError.ToValue(), nil)
+			return true
+		}
+
+		prevAborter := thread.Aborter
+		thread.Aborter = task.Aborter
+		defer func() {
+			thread.Aborter = prevAborter
+		}()
+	}
+
+	// stack traces of errors thrown by
+	// Elk functions called in the body get saved in the thread
+	thread.errStackTrace = nil
 	result, err := body.Function(thread, body.Args)
 	if !err.IsUndefined() {
-		task.Reject(err, nil)
-		return
+		task.Reject(err, thread.errStackTrace)
+		thread.errStackTrace = nil
+		return true
 	}
 
 	task.Resolve(result)
+	return true
 }
 
 func (*ThreadPool) Class() *value.Class {
@@ -121,12 +205,100 @@ func (t *ThreadPool) ThreadCount() int {
 	return len(t.Threads)
 }
 
+// Returns the time slice of promises executed in the pool.
+// Zero means that promises are never preempted.
+func (t *ThreadPool) TimeSlice() time.Duration {
+	return time.Duration(t.timeSlice.Load())
+}
+
+// Set the maximum time a promise can occupy a thread worker
+// while other tasks are waiting in the queue.
+// Zero disables preemption.
+func (t *ThreadPool) SetTimeSlice(timeSlice time.Duration) {
+	t.timeSlice.Store(int64(timeSlice))
+}
+
+// Returns the number of tasks waiting in the queue.
+func (t *ThreadPool) QueuedTaskCount() int {
+	return len(t.TaskQueue)
+}
+
+// Returns the number of tasks that are currently
+// being executed by thread workers.
+func (t *ThreadPool) RunningTaskCount() int {
+	return int(t.running.Load())
+}
+
+// Returns the number of tasks that have been resolved or rejected.
+func (t *ThreadPool) CompletedTaskCount() uint64 {
+	return t.completed.Load()
+}
+
+// Whether the pool has been closed and does not accept new tasks.
+func (t *ThreadPool) IsClosed() bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return t.closed
+}
+
+// Whether the given thread is one of the workers of the pool.
+func (t *ThreadPool) IsWorker(thread *Thread) bool {
+	return slices.Contains(t.Threads, thread)
+}
+
+// Add a new task to the queue.
+// The promise gets rejected with `Std::ThreadPool::ClosedError`
+// when the pool has been closed.
 func (t *ThreadPool) AddTask(promise *Promise) {
+	if !t.reserveTask() {
+		promise.Reject(value.Ref(threadPoolClosedError()), nil)
+		return
+	}
+
 	t.TaskQueue <- promise
 }
 
+// Register a new pending task that will be put in the queue.
+// Returns false when the pool has been closed.
+func (t *ThreadPool) reserveTask() bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.closed {
+		return false
+	}
+	t.pending++
+	return true
+}
+
+func threadPoolClosedError() *value.Object {
+	return value.NewError(value.ThreadPoolClosedErrorClass, "thread pool is closed")
+}
+
+// Wait until all tasks that have been added to the pool are completed.
+func (t *ThreadPool) Drain() {
+	t.m.Lock()
+	for t.pending > 0 {
+		t.idle.Wait()
+	}
+	t.m.Unlock()
+}
+
+// Close the pool gracefully.
+// New tasks are no longer accepted,
+// thread workers shut down after all pending tasks are completed.
 func (t *ThreadPool) Close() {
-	close(t.TaskQueue)
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.closed {
+		return
+	}
+	t.closed = true
+	if t.pending == 0 {
+		close(t.TaskQueue)
+	}
 }
 
 func initThreadPool() {
@@ -134,6 +306,106 @@ func initThreadPool() {
 
 	// Instance methods
 	c := &value.ThreadPoolClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(thread *Thread, args []value.Value) (value.Value, value.Value) {
+			threadCount := DEFAULT_THREAD_POOL_SIZE
+			if !args[1].IsUndefined() && !args[1].IsNil() {
+				n, ok := value.ToGoInt(args[1])
+				if !ok || n < 1 {
+					return value.Undefined, value.Ref(value.NewError(
+						value.OutOfRangeErrorClass,
+						fmt.Sprintf("invalid thread count: %s", args[1].Inspect()),
+					))
+				}
+				threadCount = n
+			}
+
+			queueSize := DEFAULT_THREAD_POOL_QUEUE_SIZE
+			if !args[2].IsUndefined() && !args[2].IsNil() {
+				n, ok := value.ToGoInt(args[2])
+				if !ok || n < 0 {
+					return value.Undefined, value.Ref(value.NewError(
+						value.OutOfRangeErrorClass,
+						fmt.Sprintf("invalid task queue size: %s", args[2].Inspect()),
+					))
+				}
+				queueSize = n
+			}
+
+			// thread workers inherit the standard streams of the creator
+			tp := NewThreadPool(
+				threadCount,
+				queueSize,
+				withStdinOf(thread),
+				WithStdout(thread.Stdout),
+				WithStderr(thread.Stderr),
+			)
+			return value.Ref(tp), value.Undefined
+		},
+		DefWithParameters(2),
+	)
+	Def(
+		c,
+		"spawn",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			fn := args[1]
+			promise := NewNativePromise(self, func(thread *Thread, _ []value.Value) (value.Value, value.Value) {
+				return thread.CallCallable(fn)
+			})
+			return value.Ref(promise), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"queued_task_count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			return value.SmallInt(self.QueuedTaskCount()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"running_task_count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			return value.SmallInt(self.RunningTaskCount()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"completed_task_count",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			return value.ToElkInt(int64(self.CompletedTaskCount())), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"is_closed",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			return value.BoolVal(self.IsClosed()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"drain",
+		func(thread *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			if self.IsWorker(thread) {
+				return value.Undefined, value.Ref(value.NewError(
+					value.ThreadPoolErrorClass,
+					"a thread pool cannot be drained by its own worker",
+				))
+			}
+			self.Drain()
+			return value.Nil, value.Undefined
+		},
+	)
 	Def(
 		c,
 		"thread_count",
@@ -150,6 +422,31 @@ func initThreadPool() {
 			return value.SmallInt(self.TaskQueueSize()).ToValue(), value.Undefined
 		},
 	)
+	Def(
+		c,
+		"time_slice",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			return value.TimeSpan(self.TimeSlice()).ToValue(), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"time_slice=",
+		func(vm *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*ThreadPool)(args[0].Pointer())
+			timeSlice := args[1].AsTimeSpan()
+			if timeSlice < 0 {
+				return value.Undefined, value.Ref(value.NewError(
+					value.ArgumentErrorClass,
+					fmt.Sprintf("time slice cannot be negative: %s", timeSlice.Inspect()),
+				))
+			}
+			self.SetTimeSlice(timeSlice.Native())
+			return args[1], value.Undefined
+		},
+		DefWithParameters(1),
+	)
 	Def(
 		c,
 		"close",
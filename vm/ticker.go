@@ -0,0 +1,69 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/elk-language/elk/value"
+)
+
+// Returns an error when the given time span
+// cannot be used as the interval of a ticker.
+func checkTickInterval(span value.TimeSpan) value.Value {
+	if span <= 0 {
+		return value.Ref(value.NewError(
+			value.OutOfRangeErrorClass,
+			fmt.Sprintf("invalid tick interval: %s", span.Inspect()),
+		))
+	}
+
+	return value.Undefined
+}
+
+// ::Std::Time::Ticker
+func initTicker() {
+	// Instance methods
+	c := &value.TickerClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			span := args[1].AsTimeSpan()
+			if err := checkTickInterval(span); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			return value.Ref(value.NewTicker(span)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"channel",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Ticker)(args[0].Pointer())
+			return value.Ref(self.Channel()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stop",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Ticker)(args[0].Pointer())
+			self.Stop()
+			return value.Nil, value.Undefined
+		},
+	)
+	Def(
+		c,
+		"reset",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Ticker)(args[0].Pointer())
+			span := args[1].AsTimeSpan()
+			if err := checkTickInterval(span); !err.IsUndefined() {
+				return value.Undefined, err
+			}
+			self.Reset(span)
+			return value.Nil, value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
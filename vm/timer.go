@@ -0,0 +1,46 @@
+package vm
+
+import (
+	"github.com/elk-language/elk/value"
+)
+
+// ::Std::Time::Timer
+func initTimer() {
+	// Instance methods
+	c := &value.TimerClass.MethodContainer
+	Def(
+		c,
+		"#init",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			span := args[1].AsTimeSpan()
+			return value.Ref(value.NewTimer(span)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+	Def(
+		c,
+		"channel",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Timer)(args[0].Pointer())
+			return value.Ref(self.Channel()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"stop",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Timer)(args[0].Pointer())
+			return value.BoolVal(self.Stop()), value.Undefined
+		},
+	)
+	Def(
+		c,
+		"reset",
+		func(_ *Thread, args []value.Value) (value.Value, value.Value) {
+			self := (*value.Timer)(args[0].Pointer())
+			span := args[1].AsTimeSpan()
+			return value.BoolVal(self.Reset(span)), value.Undefined
+		},
+		DefWithParameters(1),
+	)
+}
@@ -48,31 +48,7 @@ func initTuple() {
 
 			length := lengthVal.AsInt()
 
-			var start int
-			end := lengthVal.AsInt() - 1
-
-			switch r := rangeVal.(type) {
-			case *value.ClosedRange:
-				start = r.Start.AsInt()
-				end = r.End.AsInt()
-			case *value.LeftOpenRange:
-				start = r.Start.AsInt() + 1
-				end = r.End.AsInt()
-			case *value.RightOpenRange:
-				start = r.Start.AsInt()
-				end = r.End.AsInt() - 1
-			case *value.OpenRange:
-				start = r.Start.AsInt() + 1
-				end = r.End.AsInt() - 1
-			case *value.BeginlessOpenRange:
-				end = r.End.AsInt() - 1
-			case *value.BeginlessClosedRange:
-				end = r.End.AsInt()
-			case *value.EndlessOpenRange:
-				start = r.Start.AsInt() + 1
-			case *value.EndlessClosedRange:
-				start = r.Start.AsInt()
-			}
+			start, end := rangeIndexBounds(rangeVal, length)
 
 			start, err = value.NormalizeArrayIndex(start, length)
 			if err.IsNotUndefined() {
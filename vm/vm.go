@@ -6,7 +6,9 @@ package vm
 import (
 	"fmt"
 	"io"
+	"os"
 	"sync/atomic"
+	"time"
 
 	"github.com/elk-language/elk/config"
 	"github.com/elk-language/elk/lexer"
@@ -21,6 +23,12 @@ var MAX_VALUE_STACK_SIZE int
 var DefaultThreadPool = &ThreadPool{}
 var DEFAULT_THREAD_POOL_SIZE int
 var DEFAULT_THREAD_POOL_QUEUE_SIZE int
+var DEFAULT_TIME_SLICE time.Duration // 0 disables preemption of promises
+var COUNT_INSTRUCTIONS bool          // whether threads count executed instructions
+
+// Number of loop iterations between safe point checks
+// (whether the thread has been cancelled or the time slice of a promise has run out).
+const SAFE_POINT_CHECK_INTERVAL = 1024
 
 // Global counter of VM IDs
 var currentID atomic.Int64
@@ -53,6 +61,14 @@ func init() {
 		DEFAULT_THREAD_POOL_QUEUE_SIZE = 256
 	}
 
+	val, ok = config.IntFromEnvVar("ELK_TIME_SLICE")
+	if ok {
+		DEFAULT_TIME_SLICE = time.Duration(val) * time.Millisecond
+	}
+
+	val, ok = config.IntFromEnvVar("ELK_COUNT_INSTRUCTIONS")
+	COUNT_INSTRUCTIONS = ok && val != 0
+
 	DefaultThreadPool.initThreadPool(
 		DEFAULT_THREAD_POOL_SIZE,
 		DEFAULT_THREAD_POOL_QUEUE_SIZE,
@@ -74,6 +90,20 @@ func WithAborter(aborter *value.Aborter) Option {
 func WithStdin(stdin io.Reader) Option {
 	return func(vm *Thread) {
 		vm.Stdin = stdin
+		if stdin == os.Stdin {
+			vm.stdinBuffer = nil
+		} else {
+			vm.stdinBuffer = &stdinBuffer{}
+		}
+	}
+}
+
+// Assign the Stdin of the given thread to the VM,
+// the buffered reader of Stdin is shared by both threads.
+func withStdinOf(thread *Thread) Option {
+	return func(vm *Thread) {
+		vm.Stdin = thread.Stdin
+		vm.stdinBuffer = thread.stdinBuffer
 	}
 }
 
@@ -173,7 +173,7 @@ func vmSourceTest(tc sourceTestCase, t *testing.T) {
 	}
 
 	if tc.wantStdoutPattern != nil {
-		if !tc.wantStdoutPattern.MatchesString(gotStdout) {
+		if matched, err := tc.wantStdoutPattern.MatchString(gotStdout); !err.IsUndefined() || !matched {
 			t.Errorf(
 				"stdout not matched by regex\n  got: %q\n  pattern: %s",
 				gotStdout,
@@ -187,7 +187,7 @@ func vmSourceTest(tc sourceTestCase, t *testing.T) {
 	}
 
 	if tc.wantStderrPattern != nil {
-		if !tc.wantStderrPattern.MatchesString(gotStderr) {
+		if matched, err := tc.wantStderrPattern.MatchString(gotStderr); !err.IsUndefined() || !matched {
 			t.Errorf(
 				"stderr not matched by regex\n  got: %q\n  pattern: %s",
 				gotStderr,
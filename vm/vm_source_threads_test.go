@@ -2,9 +2,11 @@ package vm_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/elk-language/elk/bitfield"
 	"github.com/elk-language/elk/value"
+	"github.com/elk-language/elk/vm"
 )
 
 func TestVMSource_Go(t *testing.T) {
@@ -138,3 +140,317 @@ func TestVMSource_Go(t *testing.T) {
 		})
 	}
 }
+
+func TestVMSource_Preemption(t *testing.T) {
+	prevTimeSlice := vm.DEFAULT_TIME_SLICE
+	vm.DEFAULT_TIME_SLICE = 5 * time.Millisecond
+	defer func() {
+		vm.DEFAULT_TIME_SLICE = prevTimeSlice
+	}()
+
+	tests := sourceTestTable{
+		"preempt CPU-bound promises": {
+			source: `
+				async def spin(n: Int)
+					var i = 0
+					while i < n
+						i += 1
+					end
+					println "spin"
+				end
+
+				async def quick: String then "quick"
+
+				promises := ArrayList::[Promise[void]]()
+				for _ in 1...ThreadPool::DEFAULT.thread_count
+					promises << spin(3_000_000)
+				end
+				println(await quick())
+				for p in promises then await p
+			`,
+			wantStdout:   "quick\nspin\nspin\nspin\nspin\n",
+			wantStackTop: value.Nil,
+		},
+		"measure the run time of a thread": {
+			source: `
+				t := go
+					var i = 0
+					while i < 100
+						i += 1
+					end
+				end
+				t.join
+				timed := t.wall_time > 0.seconds
+				println timed.inspect
+				println t.instruction_count.inspect
+			`,
+			wantStdout:   "true\n0\n",
+			wantStackTop: value.Nil,
+		},
+		"get and set the time slice of a thread pool": {
+			source: `
+				prev := ThreadPool::DEFAULT.time_slice
+				ThreadPool::DEFAULT.time_slice = 5.milliseconds
+				time_slice := ThreadPool::DEFAULT.time_slice
+				println time_slice.inspect
+				ThreadPool::DEFAULT.time_slice = prev
+				nil
+			`,
+			wantStdout:   "Std::Time::Span.parse('5ms')\n",
+			wantStackTop: value.Nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vmSourceTest(tc, t)
+		})
+	}
+}
+
+func TestVMSource_CountInstructions(t *testing.T) {
+	vm.COUNT_INSTRUCTIONS = true
+	defer func() {
+		vm.COUNT_INSTRUCTIONS = false
+	}()
+
+	tc := sourceTestCase{
+		source: `
+			t := go
+				var i = 0
+				while i < 100
+					i += 1
+				end
+			end
+			t.join
+			t.instruction_count > 100
+		`,
+		wantStackTop: value.True.ToValue(),
+	}
+	vmSourceTest(tc, t)
+}
+
+func TestVMSource_ThreadHandles(t *testing.T) {
+	tests := sourceTestTable{
+		"join a thread": {
+			source: `
+				t := go 1 + 2
+				t.join
+			`,
+			wantStackTop: value.SmallInt(3).ToValue(),
+		},
+		"join a thread with a timeout": {
+			source: `
+				using Std::Thread::TimeoutError
+
+				t := go sleep 1.second
+				do
+					t.join(10.milliseconds)
+				catch TimeoutError()
+					println "timeout"
+				end
+				t.cancel
+				nil
+			`,
+			wantStdout:   "timeout\n",
+			wantStackTop: value.Nil,
+		},
+		"join a thread that failed": {
+			source: `
+				using Std::Thread::Error as ThreadError
+
+				Thread.on_uncaught_error |thread, err| -> println "uncaught: ${err.inspect}"
+				t := go throw unchecked :foo
+				do
+					t.join
+				catch ThreadError(message)
+					println message
+				end
+				Thread.clear_uncaught_error_handler
+				println t.error.inspect
+				has_stack_trace := t.stack_trace != nil
+				println has_stack_trace.inspect
+				t.is_done
+			`,
+			wantStdout:   "uncaught: :foo\nthread terminated with an uncaught error: :foo\n:foo\ntrue\n",
+			wantStackTop: value.True.ToValue(),
+		},
+		"get the current thread": {
+			source: `
+				t := go Thread.current
+				t.join == t
+			`,
+			wantStackTop: value.True.ToValue(),
+		},
+		"name a thread": {
+			source: `
+				t := go 1
+				t.name = "worker"
+				t.join
+				println t.name
+				println t.inspect
+			`,
+			wantStdout:   "worker\nStd::Thread{name: \"worker\", state: :terminated}\n",
+			wantStackTop: value.Nil,
+		},
+		"cancel a thread": {
+			source: `
+				using Std::Thread::Error as ThreadError
+
+				Thread.on_uncaught_error |thread, err| -> nil
+				t := go
+					loop; end
+				end
+				t.cancel
+				do
+					t.join
+				catch ThreadError()
+				end
+				Thread.clear_uncaught_error_handler
+				t.error
+			`,
+			wantStackTop: value.ExecutionAbortedError.ToValue(),
+		},
+		"join a thread that is not joinable": {
+			source: `
+				using Std::Thread::Error as ThreadError
+
+				do
+					Thread.current.join
+				catch ThreadError(message)
+					println message
+				end
+			`,
+			wantStdout:   "only threads started with `go` can be joined\n",
+			wantStackTop: value.Nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vmSourceTest(tc, t)
+		})
+	}
+}
+
+func TestVMSource_ThreadPools(t *testing.T) {
+	tests := sourceTestTable{
+		"create a thread pool": {
+			source: `
+				pool := ThreadPool(threads: 3, queue_size: 10)
+				println pool.inspect
+				pool.close
+				pool.is_closed
+			`,
+			wantStdout:   "Std::ThreadPool{thread_count: 3, task_queue_size: 10}\n",
+			wantStackTop: value.True.ToValue(),
+		},
+		"create a thread pool with an invalid thread count": {
+			source: `
+				ThreadPool(threads: 0)
+			`,
+			wantRuntimeErr: value.Ref(value.NewError(
+				value.OutOfRangeErrorClass,
+				"invalid thread count: 0",
+			)),
+		},
+		"spawn a closure": {
+			source: `
+				pool := ThreadPool(threads: 2)
+				p := pool.spawn -> 2 + 3
+				result := p.await_sync
+				pool.close
+				result
+			`,
+			wantStackTop: value.SmallInt(5).ToValue(),
+		},
+		"run an async method in a thread pool": {
+			source: `
+				async def add(a: Int, b: Int): Int
+					a + b
+				end
+
+				pool := ThreadPool(threads: 1)
+				p := add(2, 8, _pool: pool)
+				result := p.await_sync
+				pool.drain
+				println pool.completed_task_count.inspect
+				pool.close
+				result
+			`,
+			wantStdout:   "1\n",
+			wantStackTop: value.SmallInt(10).ToValue(),
+		},
+		"await a promise from another pool": {
+			source: `
+				io_pool := ThreadPool(threads: 1)
+				cpu_pool := ThreadPool(threads: 1)
+
+				async def compute: Int
+					21 * 2
+				end
+				async def fetch(pool: ThreadPool): Int
+					await compute(_pool: pool)
+				end
+
+				result := fetch(cpu_pool, _pool: io_pool).await_sync
+				io_pool.drain
+				cpu_pool.drain
+				println io_pool.completed_task_count.inspect
+				println cpu_pool.completed_task_count.inspect
+				io_pool.close
+				cpu_pool.close
+				result
+			`,
+			wantStdout:   "1\n1\n",
+			wantStackTop: value.SmallInt(42).ToValue(),
+		},
+		"drain a thread pool": {
+			source: `
+				pool := ThreadPool(threads: 2)
+				for _ in 1...5
+					pool.spawn -> sleep 10.milliseconds
+				end
+				pool.drain
+				println pool.queued_task_count.inspect
+				println pool.running_task_count.inspect
+				println pool.completed_task_count.inspect
+				pool.close
+			`,
+			wantStdout:   "0\n0\n5\n",
+			wantStackTop: value.Nil,
+		},
+		"spawn in a closed thread pool": {
+			source: `
+				pool := ThreadPool(threads: 1)
+				pool.close
+				pool.drain
+				pool.spawn(-> 1).await_sync
+			`,
+			wantRuntimeErr: value.Ref(value.NewError(
+				value.ThreadPoolClosedErrorClass,
+				"thread pool is closed",
+			)),
+		},
+		"drain a thread pool from its own worker": {
+			source: `
+				async def drain_pool(pool: ThreadPool)
+					pool.drain
+				end
+
+				pool := ThreadPool(threads: 1)
+				drain_pool(pool, _pool: pool).await_sync
+			`,
+			wantRuntimeErr: value.Ref(value.NewError(
+				value.ThreadPoolErrorClass,
+				"a thread pool cannot be drained by its own worker",
+			)),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vmSourceTest(tc, t)
+		})
+	}
+}